@@ -68,14 +68,14 @@ func (v *collator_[V]) CompareValues(
 	first V,
 	second V,
 ) bool {
-	return v.compareValues(ref.ValueOf(first), ref.ValueOf(second))
+	return v.compareValues(ref.ValueOf(first), ref.ValueOf(second), 0)
 }
 
 func (v *collator_[V]) RankValues(
 	first V,
 	second V,
 ) Rank {
-	return v.rankValues(ref.ValueOf(first), ref.ValueOf(second))
+	return v.rankValues(ref.ValueOf(first), ref.ValueOf(second), 0)
 }
 
 // Attribute Methods
@@ -104,12 +104,13 @@ func (v Rank) String() string {
 func (v *collator_[V]) compareArrays(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) bool {
 	// Check for maximum traversal depth.
-	if v.currentDepth_ == v.maximumDepth_ {
+	if depth == v.maximumDepth_ {
 		var message = fmt.Sprintf(
 			"The maximum traversal depth was exceeded: %v",
-			v.currentDepth_,
+			depth,
 		)
 		panic(message)
 	}
@@ -123,13 +124,10 @@ func (v *collator_[V]) compareArrays(
 
 	// Compare the values of the Go arrays.
 	for i := 0; i < size; i++ {
-		v.currentDepth_++
-		if !v.compareValues(first.Index(i), second.Index(i)) {
+		if !v.compareValues(first.Index(i), second.Index(i), depth+1) {
 			// Two of the values in the Go arrays are different.
-			v.currentDepth_--
 			return false
 		}
-		v.currentDepth_--
 	}
 	return true
 }
@@ -137,6 +135,7 @@ func (v *collator_[V]) compareArrays(
 func (v *collator_[V]) compareInterfaces(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) bool {
 	var typeRef = first.Type() // We know the structures are the same type.
 	var count = typeRef.NumMethod()
@@ -146,7 +145,7 @@ func (v *collator_[V]) compareInterfaces(
 		if sts.HasPrefix(name, "Get") && arguments == 0 {
 			var firstValue = first.Method(index).Call([]ref.Value{})[0]
 			var secondValue = second.Method(index).Call([]ref.Value{})[0]
-			if !v.compareValues(firstValue, secondValue) {
+			if !v.compareValues(firstValue, secondValue, depth) {
 				// Found a difference.
 				return false
 			}
@@ -159,12 +158,13 @@ func (v *collator_[V]) compareInterfaces(
 func (v *collator_[V]) compareMaps(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) bool {
 	// Check for maximum traversal depth.
-	if v.currentDepth_ == v.maximumDepth_ {
+	if depth == v.maximumDepth_ {
 		var message = fmt.Sprintf(
 			"The maximum traversal depth was exceeded: %v",
-			v.currentDepth_,
+			depth,
 		)
 		panic(message)
 	}
@@ -178,16 +178,13 @@ func (v *collator_[V]) compareMaps(
 	// Compare the keys and values for the two Go maps.
 	var iterator = first.MapRange()
 	for iterator.Next() {
-		v.currentDepth_++
 		var key = iterator.Key()
 		var firstValue = iterator.Value()
 		var secondValue = second.MapIndex(key)
-		if !v.compareValues(firstValue, secondValue) {
+		if !v.compareValues(firstValue, secondValue, depth+1) {
 			// The values don't match.
-			v.currentDepth_--
 			return false
 		}
-		v.currentDepth_--
 	}
 	return true
 }
@@ -202,16 +199,18 @@ func (v *collator_[V]) compareIntrinsics(
 func (v *collator_[V]) compareSequences(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) bool {
 	// Compare the Go arrays for the two sequences.
 	var firstArray = first.MethodByName("AsArray").Call([]ref.Value{})[0]
 	var secondArray = second.MethodByName("AsArray").Call([]ref.Value{})[0]
-	return v.compareArrays(firstArray, secondArray)
+	return v.compareArrays(firstArray, secondArray, depth)
 }
 
 func (v *collator_[V]) compareValues(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) bool {
 	// Handle any invalid values.
 	if !first.IsValid() {
@@ -249,7 +248,7 @@ func (v *collator_[V]) compareValues(
 		case second.IsNil():
 			return false // We know that first isn't nil.
 		default:
-			return v.compareArrays(first, second)
+			return v.compareArrays(first, second, depth)
 		}
 	case ref.Map:
 		switch {
@@ -258,7 +257,7 @@ func (v *collator_[V]) compareValues(
 		case second.IsNil():
 			return false // We know that first isn't nil.
 		default:
-			return v.compareMaps(first, second)
+			return v.compareMaps(first, second, depth)
 		}
 
 	// Handle all interfaces and pointers.
@@ -270,15 +269,15 @@ func (v *collator_[V]) compareValues(
 			return false // We know that first isn't nil.
 		case first.MethodByName("AsArray").IsValid():
 			// The value is a sequence.
-			return v.compareSequences(first, second)
+			return v.compareSequences(first, second, depth)
 		case first.NumMethod() > 0:
 			// The value is an interface or pointer to a structure with methods.
-			return v.compareInterfaces(first, second)
+			return v.compareInterfaces(first, second, depth)
 		default:
 			// The values are pointers to the values to be compared.
 			first = first.Elem()
 			second = second.Elem()
-			return v.compareValues(first, second)
+			return v.compareValues(first, second, depth)
 		}
 
 	// Handle all Go structures.
@@ -343,12 +342,13 @@ func (v *collator_[V]) getType(
 func (v *collator_[V]) rankArrays(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) Rank {
 	// Check for maximum traversal depth.
-	if v.currentDepth_ == v.maximumDepth_ {
+	if depth == v.maximumDepth_ {
 		var message = fmt.Sprintf(
 			"The maximum traversal depth was exceeded: %v",
-			v.currentDepth_,
+			depth,
 		)
 		panic(message)
 	}
@@ -358,7 +358,7 @@ func (v *collator_[V]) rankArrays(
 	var secondSize = second.Len()
 	if firstSize > secondSize {
 		// Swap the order of the Go arrays and reverse the result.
-		switch v.rankArrays(second, first) {
+		switch v.rankArrays(second, first, depth) {
 		case LesserRank:
 			return GreaterRank
 		case GreaterRank:
@@ -370,15 +370,12 @@ func (v *collator_[V]) rankArrays(
 
 	// Iterate through the smallest Go array.
 	for i := 0; i < firstSize; i++ {
-		v.currentDepth_++
-		var rank = v.rankValues(first.Index(i), second.Index(i))
+		var rank = v.rankValues(first.Index(i), second.Index(i), depth+1)
 		if rank != EqualRank {
 			// The values are different.
-			v.currentDepth_--
 			return rank
 		}
 		// The two values match.
-		v.currentDepth_--
 	}
 
 	// The Go arrays contain the same initial values.
@@ -481,6 +478,7 @@ func (v *collator_[V]) rankFloats(
 func (v *collator_[V]) rankInterfaces(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) Rank {
 	var typeRef = first.Type() // We know the structures are the same type.
 	var count = first.NumMethod()
@@ -489,7 +487,7 @@ func (v *collator_[V]) rankInterfaces(
 		if sts.HasPrefix(method.Name, "Get") {
 			var firstValue = first.Method(index).Call([]ref.Value{})[0]
 			var secondValue = second.Method(index).Call([]ref.Value{})[0]
-			var rank = v.rankValues(firstValue, secondValue)
+			var rank = v.rankValues(firstValue, secondValue, depth)
 			if rank != EqualRank {
 				// Found a difference.
 				return rank
@@ -511,19 +509,23 @@ func (v *collator_[V]) rankInterfaces(
 func (v *collator_[V]) rankMaps(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) Rank {
 	// Check for maximum traversal depth.
-	if v.currentDepth_ == v.maximumDepth_ {
+	if depth == v.maximumDepth_ {
 		var message = fmt.Sprintf(
 			"The maximum traversal depth was exceeded: %v",
-			v.currentDepth_,
+			depth,
 		)
 		panic(message)
 	}
 
 	// Extract and sort the keys for the two Go maps.
 	var sorterClass = SorterClass[ref.Value]()
-	var sorter = sorterClass.SorterWithRanker(v.rankValues)
+	var ranker = func(first ref.Value, second ref.Value) Rank {
+		return v.rankValues(first, second, depth)
+	}
+	var sorter = sorterClass.SorterWithRanker(ranker)
 	var firstKeys = first.MapKeys() // The returned keys are in random order.
 	sorter.SortValues(firstKeys)
 	var secondKeys = second.MapKeys() // The returned keys are in random order.
@@ -534,7 +536,7 @@ func (v *collator_[V]) rankMaps(
 	var secondSize = len(secondKeys)
 	if firstSize > secondSize {
 		// Swap the order of the Go maps and reverse the result.
-		switch v.rankMaps(second, first) {
+		switch v.rankMaps(second, first, depth) {
 		case LesserRank:
 			return GreaterRank
 		case GreaterRank:
@@ -546,28 +548,24 @@ func (v *collator_[V]) rankMaps(
 
 	// Iterate through the smallest Go map.
 	for i := 0; i < firstSize; i++ {
-		v.currentDepth_++
 
 		// Rank the two keys.
 		var firstKey = firstKeys[i]
 		var secondKey = secondKeys[i]
-		var keyRank = v.rankValues(firstKey, secondKey)
+		var keyRank = v.rankValues(firstKey, secondKey, depth+1)
 		if keyRank != EqualRank {
 			// The two keys are different.
-			v.currentDepth_--
 			return keyRank
 		}
 
 		// The two keys match so rank the corresponding values.
 		var firstValue = first.MapIndex(firstKey)
 		var secondValue = second.MapIndex(secondKey)
-		var valueRank = v.rankValues(firstValue, secondValue)
+		var valueRank = v.rankValues(firstValue, secondValue, depth+1)
 		if valueRank != EqualRank {
 			// The two values are different.
-			v.currentDepth_--
 			return valueRank
 		}
-		v.currentDepth_--
 	}
 
 	// The Go maps contain the same initial associations.
@@ -641,11 +639,12 @@ func (v *collator_[V]) rankRunes(
 func (v *collator_[V]) rankSequences(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) Rank {
 	// Rank the Go arrays for the two sequences.
 	var firstArray = first.MethodByName("AsArray").Call([]ref.Value{})[0]
 	var secondArray = second.MethodByName("AsArray").Call([]ref.Value{})[0]
-	return v.rankArrays(firstArray, secondArray)
+	return v.rankArrays(firstArray, secondArray, depth)
 }
 
 func (v *collator_[V]) rankSigned(
@@ -680,13 +679,14 @@ func (v *collator_[V]) rankStrings(
 func (v *collator_[V]) rankStructures(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) Rank {
 	var count = first.NumField() // The structures are the same type.
 	for index := 0; index < count; index++ {
 		var firstField = first.Field(index)
 		var secondField = second.Field(index)
 		if firstField.CanInterface() {
-			var rank = v.rankValues(firstField, secondField)
+			var rank = v.rankValues(firstField, secondField, depth)
 			if rank != EqualRank {
 				// Found a difference.
 				return rank
@@ -713,6 +713,7 @@ func (v *collator_[V]) rankUnsigned(
 func (v *collator_[V]) rankValues(
 	first ref.Value,
 	second ref.Value,
+	depth uint,
 ) Rank {
 	// Handle any nil pointers.
 	if !first.IsValid() {
@@ -759,7 +760,7 @@ func (v *collator_[V]) rankValues(
 		case second.IsNil():
 			return GreaterRank // We know that first isn't nil.
 		default:
-			return v.rankArrays(first, second)
+			return v.rankArrays(first, second, depth)
 		}
 	case ref.Map:
 		switch {
@@ -772,7 +773,7 @@ func (v *collator_[V]) rankValues(
 		case second.IsNil():
 			return GreaterRank // We know that first isn't nil.
 		default:
-			return v.rankMaps(first, second)
+			return v.rankMaps(first, second, depth)
 		}
 
 	// Handle all interfaces and pointers.
@@ -788,26 +789,26 @@ func (v *collator_[V]) rankValues(
 			return GreaterRank // We know that first isn't nil.
 		case first.MethodByName("AsArray").IsValid():
 			// The value is a collection.
-			return v.rankSequences(first, second)
+			return v.rankSequences(first, second, depth)
 		case first.NumMethod() > 0:
 			// The value is an interface or pointer to a structure with methods.
-			return v.rankInterfaces(first, second)
+			return v.rankInterfaces(first, second, depth)
 		default:
 			// The values are pointers to the values to be ranked.
 			first = first.Elem()
 			second = second.Elem()
-			return v.rankValues(first, second)
+			return v.rankValues(first, second, depth)
 		}
 
 	// Handle all Go structures.
 	case ref.Struct:
 		// Rank the corresponding fields for each structure.
-		var ranking = v.rankStructures(first, second)
+		var ranking = v.rankStructures(first, second, depth)
 		if ranking != EqualRank {
 			return ranking
 		}
 		// Rank the corresponding getter values for each structure.
-		return v.rankInterfaces(first, second)
+		return v.rankInterfaces(first, second, depth)
 
 	default:
 		panic(fmt.Sprintf(
@@ -825,7 +826,6 @@ func (v *collator_[V]) rankValues(
 
 type collator_[V any] struct {
 	// Declare the instance attributes.
-	currentDepth_ uint
 	maximumDepth_ uint
 }
 
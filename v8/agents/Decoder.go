@@ -0,0 +1,610 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	bio "bufio"
+	byt "bytes"
+	bin "encoding/binary"
+	fmt "fmt"
+	io "io"
+	mat "math"
+	ref "reflect"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DecoderClass() DecoderClassLike {
+	return decoderClass()
+}
+
+// Constructor Methods
+
+func (c *decoderClass_) Decoder(
+	reader io.Reader,
+) DecoderLike {
+	if reader == nil {
+		panic("The \"reader\" attribute is required by this class.")
+	}
+	var buffered, ok = reader.(byteReader_)
+	if !ok {
+		buffered = bio.NewReader(reader)
+	}
+	var instance = &decoder_{
+		// Initialize the instance attributes.
+		reader_: buffered,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *decoder_) GetClass() DecoderClassLike {
+	return decoderClass()
+}
+
+func (v *decoder_) DecodeValue(
+	target any,
+) error {
+	var pointer = ref.ValueOf(target)
+	if pointer.Kind() != ref.Pointer || pointer.IsNil() {
+		panic("The target must be a non-nil pointer.")
+	}
+	if v.error_ != nil {
+		return v.error_
+	}
+	if v.version_ == 0 {
+		v.readHeader()
+		if v.error_ != nil {
+			return v.error_
+		}
+	}
+
+	// The end of the stream is only expected before the outermost value.
+	var tag, err = v.reader_.ReadByte()
+	if err != nil {
+		if err == io.EOF && v.depth_ > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		v.error_ = err
+		return v.error_
+	}
+	v.depth_++
+	v.decodeValue(tag, pointer.Elem())
+	v.depth_--
+	return v.error_
+}
+
+func (v *decoder_) DecodeCollator() any {
+	var name string
+	if v.DecodeValue(&name) != nil || name == "" {
+		return nil
+	}
+	return encoderClass().collatorNamed(name)
+}
+
+func (v *decoder_) ReportError(
+	err error,
+) {
+	if v.error_ == nil {
+		v.error_ = err
+	}
+}
+
+// Attribute Methods
+
+func (v *decoder_) GetVersion() uint8 {
+	return v.version_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *decoder_) decodeValue(
+	tag byte,
+	target ref.Value,
+) {
+	var class = encoderClass()
+	switch tag {
+	case class.nil_:
+		target.SetZero()
+	case class.typed_:
+		var name = v.readString()
+		if v.error_ != nil {
+			return
+		}
+		v.decodeTyped(name, target)
+	default:
+		v.decodeIntrinsic(tag, target)
+	}
+}
+
+func (v *decoder_) decodeTyped(
+	name string,
+	target ref.Value,
+) {
+	// An existing instance with the same type is decoded in place.
+	var value ref.Value
+	if target.Kind() == ref.Interface && !target.IsNil() &&
+		target.Elem().Type().String() == name {
+		value = target.Elem()
+	} else {
+		var type_, ok = encoderClass().registeredType(name)
+		if !ok && target.Type().String() == name {
+			// The target has the same type so no registration is needed.
+			type_, ok = target.Type(), true
+		}
+		if !ok {
+			v.error_ = fmt.Errorf(
+				"The type %v has not been registered.",
+				name,
+			)
+			return
+		}
+		if type_.Kind() == ref.Pointer {
+			value = ref.New(type_.Elem())
+		} else {
+			value = ref.New(type_).Elem()
+		}
+	}
+
+	var serializable, ok = value.Interface().(Serializable)
+	switch {
+	case ok:
+		serializable.DecodeValues(v)
+	case value.Kind() == ref.Pointer:
+		v.decodeNext(value.Elem())
+	default:
+		v.decodeNext(value)
+	}
+	if v.error_ != nil {
+		return
+	}
+	v.assignValue(target, value)
+}
+
+func (v *decoder_) decodeIntrinsic(
+	tag byte,
+	target ref.Value,
+) {
+	var class = encoderClass()
+	switch target.Kind() {
+	case ref.Pointer:
+		if target.IsNil() {
+			target.Set(ref.New(target.Type().Elem()))
+		}
+		v.decodeIntrinsic(tag, target.Elem())
+		return
+	case ref.Interface:
+		// The natural type for the tag is used for the value.
+		var type_ = class.naturalType(tag)
+		if type_ == nil {
+			v.mismatch(tag, target)
+			return
+		}
+		var value = ref.New(type_).Elem()
+		v.decodeIntrinsic(tag, value)
+		if v.error_ != nil {
+			return
+		}
+		v.assignValue(target, value)
+		return
+	}
+
+	var kind = ref.Kind(tag)
+	switch {
+	case tag == class.bytes_:
+		v.decodeBytes(target)
+	case kind == ref.Bool:
+		var value = v.readByte()
+		if target.Kind() != ref.Bool {
+			v.mismatch(tag, target)
+			return
+		}
+		target.SetBool(value != 0)
+	case class.isSigned(kind):
+		var integer, err = bin.ReadVarint(v.reader_)
+		v.recordError(err)
+		v.assignInteger(tag, target, integer, integer < 0)
+	case class.isUnsigned(kind):
+		var integer, err = bin.ReadUvarint(v.reader_)
+		v.recordError(err)
+		v.assignInteger(tag, target, int64(integer), false)
+	case kind == ref.Float32, kind == ref.Float64:
+		var float = v.readFloat()
+		if !class.isFloat(target.Kind()) {
+			v.mismatch(tag, target)
+			return
+		}
+		target.SetFloat(float)
+	case kind == ref.Complex64, kind == ref.Complex128:
+		var complex_ = complex(v.readFloat(), v.readFloat())
+		if target.Kind() != ref.Complex64 && target.Kind() != ref.Complex128 {
+			v.mismatch(tag, target)
+			return
+		}
+		target.SetComplex(complex_)
+	case kind == ref.String:
+		var value = v.readString()
+		if target.Kind() != ref.String {
+			v.mismatch(tag, target)
+			return
+		}
+		target.SetString(value)
+	case kind == ref.Array, kind == ref.Slice:
+		v.decodeSequence(tag, target)
+	case kind == ref.Map:
+		v.decodeMap(tag, target)
+	case kind == ref.Struct:
+		v.decodeStructure(tag, target)
+	default:
+		v.error_ = fmt.Errorf(
+			"The stream contains an invalid tag: %v.",
+			tag,
+		)
+	}
+}
+
+func (v *decoder_) decodeBytes(
+	target ref.Value,
+) {
+	var bytes = []byte(v.readString())
+	if v.error_ != nil {
+		return
+	}
+	var kind = target.Kind()
+	if (kind != ref.Slice && kind != ref.Array) ||
+		target.Type().Elem().Kind() != ref.Uint8 {
+		v.mismatch(encoderClass().bytes_, target)
+		return
+	}
+	if kind == ref.Slice {
+		target.Set(ref.MakeSlice(target.Type(), len(bytes), len(bytes)))
+	} else if target.Len() != len(bytes) {
+		v.mismatch(encoderClass().bytes_, target)
+		return
+	}
+	ref.Copy(target, ref.ValueOf(bytes))
+}
+
+func (v *decoder_) decodeMap(
+	tag byte,
+	target ref.Value,
+) {
+	var size = v.readLength()
+	if v.error_ != nil {
+		return
+	}
+	if target.Kind() != ref.Map {
+		v.mismatch(tag, target)
+		return
+	}
+	var type_ = target.Type()
+	var map_ = ref.MakeMap(type_)
+	for range size {
+		var key = ref.New(type_.Key()).Elem()
+		v.decodeNext(key)
+		var value = ref.New(type_.Elem()).Elem()
+		v.decodeNext(value)
+		if v.error_ != nil {
+			return
+		}
+		map_.SetMapIndex(key, value)
+	}
+	target.Set(map_)
+}
+
+func (v *decoder_) decodeNext(
+	target ref.Value,
+) {
+	var tag = v.readByte()
+	if v.error_ != nil {
+		return
+	}
+	v.decodeValue(tag, target)
+}
+
+func (v *decoder_) decodeSequence(
+	tag byte,
+	target ref.Value,
+) {
+	var size = v.readLength()
+	if v.error_ != nil {
+		return
+	}
+	switch target.Kind() {
+	case ref.Array:
+		if target.Len() != size {
+			v.mismatch(tag, target)
+			return
+		}
+		for index := range size {
+			v.decodeNext(target.Index(index))
+		}
+	case ref.Slice:
+		// The size is not trusted when allocating the slice.
+		var type_ = target.Type()
+		var slice = ref.MakeSlice(type_, 0, min(size, 1024))
+		for range size {
+			var value = ref.New(type_.Elem()).Elem()
+			v.decodeNext(value)
+			if v.error_ != nil {
+				return
+			}
+			slice = ref.Append(slice, value)
+		}
+		target.Set(slice)
+	default:
+		v.mismatch(tag, target)
+	}
+}
+
+func (v *decoder_) decodeStructure(
+	tag byte,
+	target ref.Value,
+) {
+	var size = v.readLength()
+	if v.error_ != nil {
+		return
+	}
+	if target.Kind() != ref.Struct {
+		v.mismatch(tag, target)
+		return
+	}
+	var fields = encoderClass().exportedFields(target.Type())
+	if len(fields) != size {
+		v.mismatch(tag, target)
+		return
+	}
+	for _, index := range fields {
+		v.decodeNext(target.Field(index))
+	}
+}
+
+func (v *decoder_) assignInteger(
+	tag byte,
+	target ref.Value,
+	integer int64,
+	negative bool,
+) {
+	if v.error_ != nil {
+		return
+	}
+	var class = encoderClass()
+	var kind = target.Kind()
+	switch {
+	case class.isSigned(kind) && !target.OverflowInt(integer):
+		target.SetInt(integer)
+	case class.isUnsigned(kind) && !negative &&
+		!target.OverflowUint(uint64(integer)):
+		target.SetUint(uint64(integer))
+	default:
+		v.mismatch(tag, target)
+	}
+}
+
+func (v *decoder_) assignValue(
+	target ref.Value,
+	value ref.Value,
+) {
+	if !value.Type().AssignableTo(target.Type()) {
+		v.error_ = fmt.Errorf(
+			"A value of type %v cannot be decoded into a target of type %v.",
+			value.Type(),
+			target.Type(),
+		)
+		return
+	}
+	target.Set(value)
+}
+
+func (v *decoder_) mismatch(
+	tag byte,
+	target ref.Value,
+) {
+	var kind = ref.Kind(tag)
+	if tag == encoderClass().bytes_ {
+		kind = ref.Slice
+	}
+	v.error_ = fmt.Errorf(
+		"A value of kind %v cannot be decoded into a target of type %v.",
+		kind,
+		target.Type(),
+	)
+}
+
+func (v *decoder_) readByte() byte {
+	if v.error_ != nil {
+		return 0
+	}
+	var value, err = v.reader_.ReadByte()
+	v.recordError(err)
+	return value
+}
+
+func (v *decoder_) readFloat() float64 {
+	var bytes = []byte(v.readBytes(8))
+	if v.error_ != nil {
+		return 0
+	}
+	return mat.Float64frombits(bin.LittleEndian.Uint64(bytes))
+}
+
+func (v *decoder_) readHeader() {
+	var class = encoderClass()
+	var magic = v.readBytes(len(class.magic_))
+	var version = v.readByte()
+	switch {
+	case v.error_ == io.ErrUnexpectedEOF && magic == "":
+		// The stream is empty.
+		v.error_ = io.EOF
+	case v.error_ != nil:
+	case magic != class.magic_:
+		v.error_ = fmt.Errorf("The stream does not contain encoded values.")
+	case version == 0 || version > class.version_:
+		v.error_ = fmt.Errorf(
+			"The stream was encoded using an unsupported version: %v",
+			version,
+		)
+	default:
+		v.version_ = version
+	}
+}
+
+func (v *decoder_) readLength() int {
+	if v.error_ != nil {
+		return 0
+	}
+	var length, err = bin.ReadUvarint(v.reader_)
+	v.recordError(err)
+	if v.error_ == nil && length > mat.MaxInt32 {
+		v.error_ = fmt.Errorf(
+			"The stream contains an invalid length: %v.",
+			length,
+		)
+	}
+	return int(length)
+}
+
+// This private method reads the specified number of bytes without trusting the
+// number when allocating the buffer.
+func (v *decoder_) readBytes(
+	size int,
+) string {
+	if v.error_ != nil {
+		return ""
+	}
+	var buffer byt.Buffer
+	var count, err = io.CopyN(&buffer, v.reader_, int64(size))
+	if err == io.EOF && count < int64(size) {
+		err = io.ErrUnexpectedEOF
+	}
+	v.recordError(err)
+	return buffer.String()
+}
+
+func (v *decoder_) readString() string {
+	var length = v.readLength()
+	return v.readBytes(length)
+}
+
+func (v *decoder_) recordError(
+	err error,
+) {
+	if v.error_ != nil || err == nil {
+		return
+	}
+	if err == io.EOF {
+		// The stream ended in the middle of a value.
+		err = io.ErrUnexpectedEOF
+	}
+	v.error_ = err
+}
+
+func (c *encoderClass_) isFloat(
+	kind ref.Kind,
+) bool {
+	return kind == ref.Float32 || kind == ref.Float64
+}
+
+func (c *encoderClass_) isSigned(
+	kind ref.Kind,
+) bool {
+	return kind >= ref.Int && kind <= ref.Int64
+}
+
+func (c *encoderClass_) isUnsigned(
+	kind ref.Kind,
+) bool {
+	return kind >= ref.Uint && kind <= ref.Uintptr
+}
+
+// This private method returns the type of value that is decoded for the
+// specified tag when the target is an interface.
+func (c *encoderClass_) naturalType(
+	tag byte,
+) ref.Type {
+	var natural any
+	switch tag {
+	case c.bytes_:
+		natural = []byte{}
+	default:
+		switch ref.Kind(tag) {
+		case ref.Bool:
+			natural = false
+		case ref.Int, ref.Int8, ref.Int16, ref.Int32, ref.Int64:
+			natural = int(0)
+		case ref.Uint, ref.Uint8, ref.Uint16, ref.Uint32, ref.Uint64, ref.Uintptr:
+			natural = uint(0)
+		case ref.Float32, ref.Float64:
+			natural = float64(0)
+		case ref.Complex64, ref.Complex128:
+			natural = complex128(0)
+		case ref.String:
+			natural = ""
+		case ref.Array, ref.Slice:
+			natural = []any{}
+		case ref.Map:
+			natural = map[any]any{}
+		default:
+			return nil
+		}
+	}
+	return ref.TypeOf(natural)
+}
+
+// Instance Structure
+
+type decoder_ struct {
+	// Declare the instance attributes.
+	reader_  byteReader_
+	version_ uint8
+	depth_   int
+	error_   error
+}
+
+// Class Structure
+
+type decoderClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func decoderClass() *decoderClass_ {
+	return decoderClassReference_
+}
+
+var decoderClassReference_ = &decoderClass_{
+	// Initialize the class constants.
+}
+
+/*
+NOTE:
+The decoder reads the varints used by the encoding a byte at a time, so a
+reader that is not already a byte reader is buffered.
+*/
+
+type byteReader_ interface {
+	io.Reader
+	io.ByteReader
+}
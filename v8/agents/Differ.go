@@ -0,0 +1,326 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	sli "slices"
+	sts "strings"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DifferClass[V any]() DifferClassLike[V] {
+	return differClass[V]()
+}
+
+// Constructor Methods
+
+func (c *differClass_[V]) Differ() DifferLike[V] {
+	var instance = &differ_[V]{
+		// Initialize the instance attributes.
+		collator_: CollatorClass[V]().Collator(),
+	}
+	return instance
+}
+
+func (c *differClass_[V]) DifferWithCollator(
+	collator CollatorLike[V],
+) DifferLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &differ_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *differ_[V]) GetClass() DifferClassLike[V] {
+	return differClass[V]()
+}
+
+func (v *differ_[V]) DiffValues(
+	first []V,
+	second []V,
+) []EditLike[V] {
+	var trace = v.traceFrontiers(first, second)
+	var edits = v.backtrackEdits(first, second, trace)
+	sli.Reverse(edits)
+	return edits
+}
+
+func (v *differ_[V]) PatchValues(
+	values []V,
+	edits []EditLike[V],
+) []V {
+	var class = differClass[V]()
+	var result = make([]V, 0, len(values))
+	var index int
+	for _, edit := range edits {
+		switch edit.GetOperation() {
+		case KeepEdit:
+			v.verifyValue(values, index, edit.GetValue())
+			result = append(result, values[index])
+			index++
+		case InsertEdit:
+			result = append(result, edit.GetValue())
+		case RemoveEdit:
+			v.verifyValue(values, index, edit.GetValue())
+			index++
+		case ChangeEdit:
+			v.verifyValue(values, index, edit.GetPrevious())
+			result = append(result, edit.GetValue())
+			index++
+		}
+	}
+	if index != len(values) {
+		panic(class.mismatch_)
+	}
+	return result
+}
+
+func (v *differ_[V]) DiffMembers(
+	first []V,
+	second []V,
+) []EditLike[V] {
+	var editClass = editClass[V]()
+	var sorter = SorterClass[V]().SorterWithRanker(v.collator_.RankValues)
+	first = sli.Clone(first)
+	sorter.SortValues(first)
+	second = sli.Clone(second)
+	sorter.SortValues(second)
+	var edits []EditLike[V]
+	var i, j int
+	for i < len(first) && j < len(second) {
+		switch v.collator_.RankValues(first[i], second[j]) {
+		case LesserRank:
+			edits = append(edits, editClass.Edit(RemoveEdit, first[i]))
+			i++
+		case GreaterRank:
+			edits = append(edits, editClass.Edit(InsertEdit, second[j]))
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	for ; i < len(first); i++ {
+		edits = append(edits, editClass.Edit(RemoveEdit, first[i]))
+	}
+	for ; j < len(second); j++ {
+		edits = append(edits, editClass.Edit(InsertEdit, second[j]))
+	}
+	return edits
+}
+
+func (v *differ_[V]) PatchMembers(
+	values []V,
+	edits []EditLike[V],
+) []V {
+	var class = differClass[V]()
+	var result = sli.Clone(values)
+	for _, edit := range edits {
+		var value = edit.GetValue()
+		var index = sli.IndexFunc(result, func(member V) bool {
+			return v.collator_.CompareValues(member, value)
+		})
+		switch edit.GetOperation() {
+		case KeepEdit:
+			if index < 0 {
+				panic(class.mismatch_)
+			}
+		case InsertEdit:
+			if index >= 0 {
+				panic(class.mismatch_)
+			}
+			result = append(result, value)
+		case RemoveEdit:
+			if index < 0 {
+				panic(class.mismatch_)
+			}
+			result = sli.Delete(result, index, index+1)
+		default:
+			panic(class.mismatch_)
+		}
+	}
+	return result
+}
+
+func (v *differ_[V]) FormatEdits(
+	edits []EditLike[V],
+) string {
+	var builder sts.Builder
+	for _, edit := range edits {
+		builder.WriteString(fmt.Sprintf("%v\n", edit))
+	}
+	return builder.String()
+}
+
+// Attribute Methods
+
+func (v *differ_[V]) GetCollator() CollatorLike[V] {
+	return v.collator_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private method returns the furthest reaching point on each diagonal of
+// the edit graph prior to each additional edit, as described in "An O(ND)
+// Difference Algorithm and Its Variations" by Eugene W. Myers.  Diagonal k is
+// stored at slot k+offset.
+func (v *differ_[V]) traceFrontiers(
+	first []V,
+	second []V,
+) [][]int {
+	var n = len(first)
+	var m = len(second)
+	var offset = n + m + 1
+	var frontier = make([]int, 2*offset+1)
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, sli.Clone(frontier))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && frontier[offset+k-1] < frontier[offset+k+1]) {
+				x = frontier[offset+k+1] // Move down (insert).
+			} else {
+				x = frontier[offset+k-1] + 1 // Move right (remove).
+			}
+			var y = x - k
+			for x < n && y < m && v.collator_.CompareValues(first[x], second[y]) {
+				x++
+				y++
+			}
+			frontier[offset+k] = x
+			if x >= n && y >= m {
+				return trace
+			}
+		}
+	}
+	return trace
+}
+
+// This private method walks the trace backward from the end of the edit graph
+// and returns the resulting edits in reverse order.
+func (v *differ_[V]) backtrackEdits(
+	first []V,
+	second []V,
+	trace [][]int,
+) []EditLike[V] {
+	var editClass = editClass[V]()
+	var offset = len(first) + len(second) + 1
+	var edits []EditLike[V]
+	var x = len(first)
+	var y = len(second)
+	for d := len(trace) - 1; d >= 0; d-- {
+		var frontier = trace[d]
+		var k = x - y
+		var previous int
+		if k == -d || (k != d && frontier[offset+k-1] < frontier[offset+k+1]) {
+			previous = k + 1
+		} else {
+			previous = k - 1
+		}
+		var previousX = frontier[offset+previous]
+		var previousY = previousX - previous
+		for x > previousX && y > previousY {
+			x--
+			y--
+			edits = append(edits, editClass.Edit(KeepEdit, first[x]))
+		}
+		if d > 0 {
+			if x == previousX {
+				edits = append(edits, editClass.Edit(InsertEdit, second[y-1]))
+			} else {
+				edits = append(edits, editClass.Edit(RemoveEdit, first[x-1]))
+			}
+			x = previousX
+			y = previousY
+		}
+	}
+	return edits
+}
+
+func (v *differ_[V]) verifyValue(
+	values []V,
+	index int,
+	expected V,
+) {
+	if index < 0 || index >= len(values) {
+		panic(differClass[V]().mismatch_)
+	}
+	if !v.collator_.CompareValues(values[index], expected) {
+		panic(differClass[V]().mismatch_)
+	}
+}
+
+// Instance Structure
+
+type differ_[V any] struct {
+	// Declare the instance attributes.
+	collator_ CollatorLike[V]
+}
+
+// Class Structure
+
+type differClass_[V any] struct {
+	// Declare the class constants.
+	mismatch_ string
+}
+
+// Class Reference
+
+var differMap_ = map[string]any{}
+var differMutex_ syn.Mutex
+
+func differClass[V any]() *differClass_[V] {
+	// Generate the name of the bound class type.
+	var class *differClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	differMutex_.Lock()
+	var value = differMap_[name]
+	switch actual := value.(type) {
+	case *differClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &differClass_[V]{
+			// Initialize the class constants.
+			mismatch_: "The edits do not apply to the values.",
+		}
+		differMap_[name] = class
+	}
+	differMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
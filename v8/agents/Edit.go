@@ -0,0 +1,161 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	fmt "fmt"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func EditClass[V any]() EditClassLike[V] {
+	return editClass[V]()
+}
+
+// Constructor Methods
+
+func (c *editClass_[V]) Edit(
+	operation Operation,
+	value V,
+) EditLike[V] {
+	var previous V
+	var instance = &edit_[V]{
+		// Initialize the instance attributes.
+		operation_: operation,
+		value_:     value,
+		previous_:  previous,
+	}
+	return instance
+}
+
+func (c *editClass_[V]) EditWithPrevious(
+	operation Operation,
+	value V,
+	previous V,
+) EditLike[V] {
+	var instance = &edit_[V]{
+		// Initialize the instance attributes.
+		operation_: operation,
+		value_:     value,
+		previous_:  previous,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *edit_[V]) GetClass() EditClassLike[V] {
+	return editClass[V]()
+}
+
+// Attribute Methods
+
+func (v *edit_[V]) GetOperation() Operation {
+	return v.operation_
+}
+
+func (v *edit_[V]) GetValue() V {
+	return v.value_
+}
+
+func (v *edit_[V]) GetPrevious() V {
+	return v.previous_
+}
+
+// PROTECTED INTERFACE
+
+func (v Operation) String() string {
+	var source string
+	switch v {
+	case KeepEdit:
+		source = "KeepEdit"
+	case InsertEdit:
+		source = "InsertEdit"
+	case RemoveEdit:
+		source = "RemoveEdit"
+	case ChangeEdit:
+		source = "ChangeEdit"
+	}
+	return source
+}
+
+func (v *edit_[V]) String() string {
+	var source string
+	switch v.operation_ {
+	case KeepEdit:
+		source = fmt.Sprintf("  %v", v.value_)
+	case InsertEdit:
+		source = fmt.Sprintf("+ %v", v.value_)
+	case RemoveEdit:
+		source = fmt.Sprintf("- %v", v.value_)
+	case ChangeEdit:
+		source = fmt.Sprintf("~ %v -> %v", v.previous_, v.value_)
+	}
+	return source
+}
+
+// Private Methods
+
+// Instance Structure
+
+type edit_[V any] struct {
+	// Declare the instance attributes.
+	operation_ Operation
+	value_     V
+	previous_  V
+}
+
+// Class Structure
+
+type editClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var editMap_ = map[string]any{}
+var editMutex_ syn.Mutex
+
+func editClass[V any]() *editClass_[V] {
+	// Generate the name of the bound class type.
+	var class *editClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	editMutex_.Lock()
+	var value = editMap_[name]
+	switch actual := value.(type) {
+	case *editClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &editClass_[V]{
+			// Initialize the class constants.
+		}
+		editMap_[name] = class
+	}
+	editMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
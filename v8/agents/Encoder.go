@@ -0,0 +1,352 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	bio "bufio"
+	bin "encoding/binary"
+	fmt "fmt"
+	io "io"
+	mat "math"
+	ref "reflect"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func EncoderClass() EncoderClassLike {
+	return encoderClass()
+}
+
+// Constructor Methods
+
+func (c *encoderClass_) Encoder(
+	writer io.Writer,
+) EncoderLike {
+	if writer == nil {
+		panic("The \"writer\" attribute is required by this class.")
+	}
+	var instance = &encoder_{
+		// Initialize the instance attributes.
+		writer_: bio.NewWriter(writer),
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *encoderClass_) RegisterType(
+	value any,
+) {
+	if value == nil {
+		panic("The \"value\" attribute is required by this class.")
+	}
+	var type_ = ref.TypeOf(value)
+	c.mutex_.Lock()
+	c.types_[type_.String()] = type_
+	c.mutex_.Unlock()
+}
+
+func (c *encoderClass_) RegisterCollator(
+	name string,
+	collator any,
+) {
+	if name == "" {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	if collator == nil {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	c.mutex_.Lock()
+	c.collators_[name] = collator
+	c.mutex_.Unlock()
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *encoder_) GetClass() EncoderClassLike {
+	return encoderClass()
+}
+
+func (v *encoder_) EncodeValue(
+	value any,
+) error {
+	if v.error_ != nil {
+		return v.error_
+	}
+	var class = encoderClass()
+	if !v.started_ {
+		v.started_ = true
+		v.writeBytes([]byte(class.magic_))
+		v.writeByte(class.version_)
+	}
+
+	// Serializable values encode their attributes by calling this method so
+	// the stream is only flushed once the outermost value has been encoded.
+	v.depth_++
+	v.encodeValue(ref.ValueOf(value))
+	v.depth_--
+	if v.depth_ == 0 && v.error_ == nil {
+		v.error_ = v.writer_.Flush()
+	}
+	return v.error_
+}
+
+func (v *encoder_) EncodeCollator(
+	collator any,
+) {
+	var class = encoderClass()
+	var name string
+	class.mutex_.RLock()
+	for key, registered := range class.collators_ {
+		if registered == collator {
+			name = key
+			break
+		}
+	}
+	class.mutex_.RUnlock()
+	v.EncodeValue(name)
+}
+
+// Attribute Methods
+
+func (v *encoder_) GetVersion() uint8 {
+	return encoderClass().version_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *encoder_) encodeValue(
+	value ref.Value,
+) {
+	if v.error_ != nil {
+		return
+	}
+	var class = encoderClass()
+	if value.Kind() == ref.Interface {
+		value = value.Elem()
+	}
+	if !value.IsValid() || (value.Kind() == ref.Pointer && value.IsNil()) {
+		v.writeByte(class.nil_)
+		return
+	}
+	var serializable, ok = value.Interface().(Serializable)
+	if ok {
+		v.writeByte(class.typed_)
+		v.writeString(value.Type().String())
+		serializable.EncodeValues(v)
+		return
+	}
+	if class.isRegistered(value.Type()) {
+		v.writeByte(class.typed_)
+		v.writeString(value.Type().String())
+	}
+	v.encodeIntrinsic(value)
+}
+
+func (v *encoder_) encodeIntrinsic(
+	value ref.Value,
+) {
+	var class = encoderClass()
+	var kind = value.Kind()
+	switch kind {
+	case ref.Bool:
+		v.writeByte(byte(kind))
+		if value.Bool() {
+			v.writeByte(1)
+		} else {
+			v.writeByte(0)
+		}
+	case ref.Int, ref.Int8, ref.Int16, ref.Int32, ref.Int64:
+		v.writeByte(byte(kind))
+		v.writeBytes(bin.AppendVarint(nil, value.Int()))
+	case ref.Uint, ref.Uint8, ref.Uint16, ref.Uint32, ref.Uint64, ref.Uintptr:
+		v.writeByte(byte(kind))
+		v.writeBytes(bin.AppendUvarint(nil, value.Uint()))
+	case ref.Float32, ref.Float64:
+		v.writeByte(byte(kind))
+		v.writeFloat(value.Float())
+	case ref.Complex64, ref.Complex128:
+		v.writeByte(byte(kind))
+		v.writeFloat(real(value.Complex()))
+		v.writeFloat(imag(value.Complex()))
+	case ref.String:
+		v.writeByte(byte(kind))
+		v.writeString(value.String())
+	case ref.Array, ref.Slice:
+		if value.Type().Elem().Kind() == ref.Uint8 {
+			// Byte arrays are encoded compactly.
+			var bytes = make([]byte, value.Len())
+			ref.Copy(ref.ValueOf(bytes), value)
+			v.writeByte(class.bytes_)
+			v.writeString(string(bytes))
+			return
+		}
+		v.writeByte(byte(kind))
+		v.writeLength(value.Len())
+		for index := range value.Len() {
+			v.encodeValue(value.Index(index))
+		}
+	case ref.Map:
+		v.writeByte(byte(kind))
+		v.writeLength(value.Len())
+		var iterator = value.MapRange()
+		for iterator.Next() {
+			v.encodeValue(iterator.Key())
+			v.encodeValue(iterator.Value())
+		}
+	case ref.Pointer:
+		v.encodeValue(value.Elem())
+	case ref.Struct:
+		var fields = class.exportedFields(value.Type())
+		v.writeByte(byte(kind))
+		v.writeLength(len(fields))
+		for _, index := range fields {
+			v.encodeValue(value.Field(index))
+		}
+	default:
+		v.error_ = fmt.Errorf(
+			"A value of type %v cannot be encoded.",
+			value.Type(),
+		)
+	}
+}
+
+func (v *encoder_) writeByte(
+	value byte,
+) {
+	if v.error_ != nil {
+		return
+	}
+	v.error_ = v.writer_.WriteByte(value)
+}
+
+func (v *encoder_) writeBytes(
+	bytes []byte,
+) {
+	if v.error_ != nil {
+		return
+	}
+	_, v.error_ = v.writer_.Write(bytes)
+}
+
+func (v *encoder_) writeFloat(
+	value float64,
+) {
+	v.writeBytes(bin.LittleEndian.AppendUint64(nil, mat.Float64bits(value)))
+}
+
+func (v *encoder_) writeLength(
+	length int,
+) {
+	v.writeBytes(bin.AppendUvarint(nil, uint64(length)))
+}
+
+func (v *encoder_) writeString(
+	value string,
+) {
+	v.writeLength(len(value))
+	if v.error_ != nil {
+		return
+	}
+	_, v.error_ = v.writer_.WriteString(value)
+}
+
+func (c *encoderClass_) collatorNamed(
+	name string,
+) any {
+	c.mutex_.RLock()
+	defer c.mutex_.RUnlock()
+	return c.collators_[name]
+}
+
+func (c *encoderClass_) exportedFields(
+	type_ ref.Type,
+) []int {
+	var fields []int
+	for index := range type_.NumField() {
+		if type_.Field(index).IsExported() {
+			fields = append(fields, index)
+		}
+	}
+	return fields
+}
+
+func (c *encoderClass_) isRegistered(
+	type_ ref.Type,
+) bool {
+	c.mutex_.RLock()
+	defer c.mutex_.RUnlock()
+	var _, ok = c.types_[type_.String()]
+	return ok
+}
+
+func (c *encoderClass_) registeredType(
+	name string,
+) (ref.Type, bool) {
+	c.mutex_.RLock()
+	defer c.mutex_.RUnlock()
+	var type_, ok = c.types_[name]
+	return type_, ok
+}
+
+// Instance Structure
+
+type encoder_ struct {
+	// Declare the instance attributes.
+	writer_  *bio.Writer
+	started_ bool
+	depth_   int
+	error_   error
+}
+
+// Class Structure
+
+type encoderClass_ struct {
+	// Declare the class constants.
+	magic_     string
+	version_   uint8
+	nil_       byte
+	bytes_     byte
+	typed_     byte
+	mutex_     syn.RWMutex
+	types_     map[string]ref.Type
+	collators_ map[string]any
+}
+
+// Class Reference
+
+func encoderClass() *encoderClass_ {
+	return encoderClassReference_
+}
+
+// The tag for each intrinsic value is its reflected kind, so the additional
+// tags use values that are greater than any kind.
+var encoderClassReference_ = &encoderClass_{
+	// Initialize the class constants.
+	magic_:     "CDCF",
+	version_:   1,
+	nil_:       byte(ref.Invalid),
+	bytes_:     0x80,
+	typed_:     0x81,
+	types_:     map[string]ref.Type{},
+	collators_: map[string]any{},
+}
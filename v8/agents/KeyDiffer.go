@@ -0,0 +1,238 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func KeyDifferClass[V any, K comparable]() KeyDifferClassLike[V, K] {
+	return keyDifferClass[V, K]()
+}
+
+// Constructor Methods
+
+func (c *keyDifferClass_[V, K]) KeyDiffer(
+	extractor ExtractorFunction[V, K],
+) KeyDifferLike[V, K] {
+	var collator = CollatorClass[V]().Collator()
+	var instance = c.KeyDifferWithCollator(extractor, collator)
+	return instance
+}
+
+func (c *keyDifferClass_[V, K]) KeyDifferWithCollator(
+	extractor ExtractorFunction[V, K],
+	collator CollatorLike[V],
+) KeyDifferLike[V, K] {
+	if uti.IsUndefined(extractor) {
+		panic("The \"extractor\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &keyDiffer_[V, K]{
+		// Initialize the instance attributes.
+		extractor_: extractor,
+		collator_:  collator,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *keyDiffer_[V, K]) GetClass() KeyDifferClassLike[V, K] {
+	return keyDifferClass[V, K]()
+}
+
+func (v *keyDiffer_[V, K]) DiffValues(
+	first []V,
+	second []V,
+) []EditLike[V] {
+	var editClass = editClass[V]()
+	var edits []EditLike[V]
+	var slots = v.indexKeys(first)
+	var remaining = v.indexKeys(second)
+	for _, previous := range first {
+		var key = v.extractor_(previous)
+		var slot, ok = remaining[key]
+		switch {
+		case !ok:
+			edits = append(edits, editClass.Edit(RemoveEdit, previous))
+		case !v.collator_.CompareValues(previous, second[slot]):
+			edits = append(
+				edits,
+				editClass.EditWithPrevious(ChangeEdit, second[slot], previous),
+			)
+		}
+	}
+	for _, value := range second {
+		var _, ok = slots[v.extractor_(value)]
+		if !ok {
+			edits = append(edits, editClass.Edit(InsertEdit, value))
+		}
+	}
+	return edits
+}
+
+func (v *keyDiffer_[V, K]) PatchValues(
+	values []V,
+	edits []EditLike[V],
+) []V {
+	// The values are indexed by their keys once, and removed values are only
+	// marked so that the slots of the remaining values are unaffected.
+	var class = keyDifferClass[V, K]()
+	var result = make([]V, len(values), len(values)+len(edits))
+	copy(result, values)
+	var removed = make([]bool, len(values), len(values)+len(edits))
+	var slots = v.indexKeys(values)
+	for _, edit := range edits {
+		var value = edit.GetValue()
+		var key = v.extractor_(value)
+		var slot, ok = slots[key]
+		switch edit.GetOperation() {
+		case KeepEdit:
+			v.verifyValue(result, slot, ok, value)
+		case InsertEdit:
+			if ok {
+				panic(class.mismatch_)
+			}
+			slots[key] = len(result)
+			result = append(result, value)
+			removed = append(removed, false)
+		case RemoveEdit:
+			v.verifyValue(result, slot, ok, value)
+			delete(slots, key)
+			removed[slot] = true
+		case ChangeEdit:
+			v.verifyValue(result, slot, ok, edit.GetPrevious())
+			result[slot] = value
+		default:
+			panic(class.mismatch_)
+		}
+	}
+
+	// Remove the values that were marked as removed.
+	var size = 0
+	for slot, value := range result {
+		if !removed[slot] {
+			result[size] = value
+			size++
+		}
+	}
+	return result[:size]
+}
+
+// Attribute Methods
+
+func (v *keyDiffer_[V, K]) GetExtractor() ExtractorFunction[V, K] {
+	return v.extractor_
+}
+
+func (v *keyDiffer_[V, K]) GetCollator() CollatorLike[V] {
+	return v.collator_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private method returns a map from the key of each of the specified
+// values to its slot in the Go array.  Since each key must identify a single
+// value, any duplicate key results in a panic.
+func (v *keyDiffer_[V, K]) indexKeys(
+	values []V,
+) map[K]int {
+	var slots = make(map[K]int, len(values))
+	for slot, value := range values {
+		var key = v.extractor_(value)
+		var _, exists = slots[key]
+		if exists {
+			var message = fmt.Sprintf(
+				"The key %v appears more than once in the values.",
+				key,
+			)
+			panic(message)
+		}
+		slots[key] = slot
+	}
+	return slots
+}
+
+func (v *keyDiffer_[V, K]) verifyValue(
+	values []V,
+	slot int,
+	ok bool,
+	expected V,
+) {
+	if !ok || !v.collator_.CompareValues(values[slot], expected) {
+		panic(keyDifferClass[V, K]().mismatch_)
+	}
+}
+
+// Instance Structure
+
+type keyDiffer_[V any, K comparable] struct {
+	// Declare the instance attributes.
+	extractor_ ExtractorFunction[V, K]
+	collator_  CollatorLike[V]
+}
+
+// Class Structure
+
+type keyDifferClass_[V any, K comparable] struct {
+	// Declare the class constants.
+	mismatch_ string
+}
+
+// Class Reference
+
+var keyDifferMap_ = map[string]any{}
+var keyDifferMutex_ syn.Mutex
+
+func keyDifferClass[V any, K comparable]() *keyDifferClass_[V, K] {
+	// Generate the name of the bound class type.
+	var class *keyDifferClass_[V, K]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	keyDifferMutex_.Lock()
+	var value = keyDifferMap_[name]
+	switch actual := value.(type) {
+	case *keyDifferClass_[V, K]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &keyDifferClass_[V, K]{
+			// Initialize the class constants.
+			mismatch_: "The edits do not apply to the values.",
+		}
+		keyDifferMap_[name] = class
+	}
+	keyDifferMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
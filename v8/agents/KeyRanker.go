@@ -0,0 +1,91 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func KeyRankerClass[V any, K any]() KeyRankerClassLike[V, K] {
+	return keyRankerClass[V, K]()
+}
+
+// Constructor Methods
+
+// Constant Methods
+
+// Function Methods
+
+func (c *keyRankerClass_[V, K]) RankBy(
+	extractor ExtractorFunction[V, K],
+) RankingFunction[V] {
+	var collator = CollatorClass[K]().Collator()
+	return c.RankByWithRanker(extractor, collator.RankValues)
+}
+
+func (c *keyRankerClass_[V, K]) RankByWithRanker(
+	extractor ExtractorFunction[V, K],
+	ranker RankingFunction[K],
+) RankingFunction[V] {
+	if uti.IsUndefined(extractor) {
+		panic("The \"extractor\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(first V, second V) Rank {
+		return ranker(extractor(first), extractor(second))
+	}
+}
+
+// Class Structure
+
+type keyRankerClass_[V any, K any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var keyRankerMap_ = map[string]any{}
+var keyRankerMutex_ syn.Mutex
+
+func keyRankerClass[V any, K any]() *keyRankerClass_[V, K] {
+	// Generate the name of the bound class type.
+	var class *keyRankerClass_[V, K]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	keyRankerMutex_.Lock()
+	var value = keyRankerMap_[name]
+	switch actual := value.(type) {
+	case *keyRankerClass_[V, K]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &keyRankerClass_[V, K]{
+			// Initialize the class constants.
+		}
+		keyRankerMap_[name] = class
+	}
+	keyRankerMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -0,0 +1,271 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	ref "reflect"
+	syn "sync"
+	uni "unicode"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RankerClass[V any]() RankerClassLike[V] {
+	return rankerClass[V]()
+}
+
+// Constructor Methods
+
+// Constant Methods
+
+// Function Methods
+
+func (c *rankerClass_[V]) ThenBy(
+	first RankingFunction[V],
+	second RankingFunction[V],
+) RankingFunction[V] {
+	if uti.IsUndefined(first) || uti.IsUndefined(second) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(firstValue V, secondValue V) Rank {
+		var rank = first(firstValue, secondValue)
+		if rank == EqualRank {
+			rank = second(firstValue, secondValue)
+		}
+		return rank
+	}
+}
+
+func (c *rankerClass_[V]) Reversed(
+	ranker RankingFunction[V],
+) RankingFunction[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(first V, second V) Rank {
+		return ranker(second, first)
+	}
+}
+
+func (c *rankerClass_[V]) NilsFirst(
+	ranker RankingFunction[V],
+) RankingFunction[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(first V, second V) Rank {
+		return c.rankNils(first, second, ranker, LesserRank)
+	}
+}
+
+func (c *rankerClass_[V]) NilsLast(
+	ranker RankingFunction[V],
+) RankingFunction[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(first V, second V) Rank {
+		return c.rankNils(first, second, ranker, GreaterRank)
+	}
+}
+
+func (c *rankerClass_[V]) IgnoringCase() RankingFunction[V] {
+	c.validateStrings()
+	return func(first V, second V) Rank {
+		return c.rankStrings(
+			ref.ValueOf(first).String(),
+			ref.ValueOf(second).String(),
+			false,
+		)
+	}
+}
+
+func (c *rankerClass_[V]) Naturally() RankingFunction[V] {
+	c.validateStrings()
+	return func(first V, second V) Rank {
+		return c.rankStrings(
+			ref.ValueOf(first).String(),
+			ref.ValueOf(second).String(),
+			true,
+		)
+	}
+}
+
+// Private Methods
+
+func (c *rankerClass_[V]) isNil(
+	value V,
+) bool {
+	var reflected = ref.ValueOf(value)
+	switch reflected.Kind() {
+	case ref.Invalid:
+		return true
+	case ref.Chan, ref.Func, ref.Interface, ref.Map, ref.Pointer, ref.Slice,
+		ref.UnsafePointer:
+		return reflected.IsNil()
+	default:
+		return false
+	}
+}
+
+func (c *rankerClass_[V]) rankNils(
+	first V,
+	second V,
+	ranker RankingFunction[V],
+	nilRank Rank,
+) Rank {
+	var firstNil = c.isNil(first)
+	var secondNil = c.isNil(second)
+	switch {
+	case firstNil && secondNil:
+		return EqualRank
+	case firstNil:
+		return nilRank
+	case secondNil:
+		return GreaterRank - nilRank
+	default:
+		return ranker(first, second)
+	}
+}
+
+// This method ranks two strings character by character ignoring their case.
+// If requested, each run of digits is ranked by its numeric value instead.
+func (c *rankerClass_[V]) rankStrings(
+	first string,
+	second string,
+	numeric bool,
+) Rank {
+	for len(first) > 0 && len(second) > 0 {
+		if numeric && c.isDigit(first[0]) && c.isDigit(second[0]) {
+			var firstDigits = c.digitsOf(first)
+			var secondDigits = c.digitsOf(second)
+			var rank = c.rankNumbers(firstDigits, secondDigits)
+			if rank != EqualRank {
+				return rank
+			}
+			first = first[len(firstDigits):]
+			second = second[len(secondDigits):]
+			continue
+		}
+		var firstRune, firstSize = utf.DecodeRuneInString(first)
+		var secondRune, secondSize = utf.DecodeRuneInString(second)
+		firstRune = uni.ToLower(firstRune)
+		secondRune = uni.ToLower(secondRune)
+		switch {
+		case firstRune < secondRune:
+			return LesserRank
+		case firstRune > secondRune:
+			return GreaterRank
+		}
+		first = first[firstSize:]
+		second = second[secondSize:]
+	}
+	switch {
+	case len(first) < len(second):
+		return LesserRank
+	case len(first) > len(second):
+		return GreaterRank
+	default:
+		return EqualRank
+	}
+}
+
+// This method ranks two runs of digits by their numeric values without
+// converting them to integers, so they may be arbitrarily long.
+func (c *rankerClass_[V]) rankNumbers(
+	first string,
+	second string,
+) Rank {
+	for len(first) > 1 && first[0] == '0' {
+		first = first[1:]
+	}
+	for len(second) > 1 && second[0] == '0' {
+		second = second[1:]
+	}
+	switch {
+	case len(first) < len(second) || (len(first) == len(second) && first < second):
+		return LesserRank
+	case len(first) > len(second) || first > second:
+		return GreaterRank
+	default:
+		return EqualRank
+	}
+}
+
+func (c *rankerClass_[V]) digitsOf(
+	source string,
+) string {
+	var length = 0
+	for length < len(source) && c.isDigit(source[length]) {
+		length++
+	}
+	return source[:length]
+}
+
+func (c *rankerClass_[V]) isDigit(
+	character byte,
+) bool {
+	return '0' <= character && character <= '9'
+}
+
+func (c *rankerClass_[V]) validateStrings() {
+	var type_ = ref.TypeFor[V]()
+	if type_.Kind() != ref.String {
+		var message = fmt.Sprintf(
+			"The values of type %v cannot be ranked as strings.",
+			type_,
+		)
+		panic(message)
+	}
+}
+
+// Class Structure
+
+type rankerClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var rankerMap_ = map[string]any{}
+var rankerMutex_ syn.Mutex
+
+func rankerClass[V any]() *rankerClass_[V] {
+	// Generate the name of the bound class type.
+	var class *rankerClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	rankerMutex_.Lock()
+	var value = rankerMap_[name]
+	switch actual := value.(type) {
+	case *rankerClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &rankerClass_[V]{
+			// Initialize the class constants.
+		}
+		rankerMap_[name] = class
+	}
+	rankerMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
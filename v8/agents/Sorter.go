@@ -14,9 +14,14 @@ package agents
 
 import (
 	ran "crypto/rand"
+	bin "encoding/binary"
 	fmt "fmt"
 	uti "github.com/craterdog/go-missing-utilities/v8"
-	big "math/big"
+	mat "math"
+	bit "math/bits"
+	rnd "math/rand/v2"
+	ref "reflect"
+	run "runtime"
 	syn "sync"
 )
 
@@ -33,7 +38,9 @@ func SorterClass[V any]() SorterClassLike[V] {
 func (c *sorterClass_[V]) Sorter() SorterLike[V] {
 	var instance = &sorter_[V]{
 		// Initialize the instance attributes.
-		ranker_: CollatorClass[V]().Collator().RankValues,
+		ranker_:  CollatorClass[V]().Collator().RankValues,
+		workers_: 1,
+		source_:  c.source_,
 	}
 	return instance
 }
@@ -46,7 +53,73 @@ func (c *sorterClass_[V]) SorterWithRanker(
 	}
 	var instance = &sorter_[V]{
 		// Initialize the instance attributes.
-		ranker_: ranker,
+		ranker_:  ranker,
+		workers_: 1,
+		source_:  c.source_,
+	}
+	return instance
+}
+
+func (c *sorterClass_[V]) SorterWithParallelism(
+	ranker RankingFunction[V],
+	workers uint,
+) SorterLike[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	if workers == 0 {
+		workers = uint(run.GOMAXPROCS(0))
+	}
+	var instance = &sorter_[V]{
+		// Initialize the instance attributes.
+		ranker_:  ranker,
+		workers_: workers,
+		source_:  c.source_,
+	}
+	return instance
+}
+
+func (c *sorterClass_[V]) SorterWithAlgorithm(
+	ranker RankingFunction[V],
+	algorithm Algorithm,
+) SorterLike[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	switch algorithm {
+	case MergeSort, PdqSort, TimSort, RadixSort, InsertionSort:
+	default:
+		var message = fmt.Sprintf(
+			"Received an invalid sorting algorithm: %v",
+			algorithm,
+		)
+		panic(message)
+	}
+	var instance = &sorter_[V]{
+		// Initialize the instance attributes.
+		ranker_:    ranker,
+		workers_:   1,
+		algorithm_: algorithm,
+		source_:    c.source_,
+	}
+	return instance
+}
+
+func (c *sorterClass_[V]) SorterWithRandomSource(
+	ranker RankingFunction[V],
+	source rnd.Source,
+) SorterLike[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(source) {
+		panic("The \"source\" attribute is required by this class.")
+	}
+	var instance = &sorter_[V]{
+		// Initialize the instance attributes.
+		ranker_:  ranker,
+		workers_: 1,
+		source_:  source,
 	}
 	return instance
 }
@@ -66,8 +139,25 @@ func (v *sorter_[V]) GetClass() SorterClassLike[V] {
 func (v *sorter_[V]) SortValues(
 	values []V,
 ) {
-	// Sort the values in place using a merge sort.
-	v.sortValues(values)
+	// Sort the values in place using the selected algorithm.
+	switch v.algorithm_ {
+	case PdqSort:
+		var limit = bit.Len(uint(len(values)))
+		v.pdqSort(values, 0, len(values), limit)
+	case TimSort:
+		v.timSort(values)
+	case RadixSort:
+		v.radixSort(values)
+	case InsertionSort:
+		v.insertionSort(values, 1)
+	default:
+		var class = sorterClass[V]()
+		if v.workers_ > 1 && len(values) >= class.threshold_ {
+			v.sortConcurrently(values)
+			return
+		}
+		v.sortValues(values)
+	}
 }
 
 func (v *sorter_[V]) ReverseValues(
@@ -84,36 +174,195 @@ func (v *sorter_[V]) ReverseValues(
 func (v *sorter_[V]) ShuffleValues(
 	values []V,
 ) {
-	// Shuffle the values in place using random index exchanges.
-	var size = len(values)
-	for i := 0; i < size; i++ {
-		var r = v.randomizeIndex(size)
-		values[i], values[r] = values[r], values[i]
+	// Shuffle the values in place using a Fisher-Yates shuffle.
+	var random = rnd.New(v.source_)
+	for i := len(values) - 1; i > 0; i-- {
+		var j = random.IntN(i + 1)
+		values[i], values[j] = values[j], values[i]
 	}
 }
 
+func (v *sorter_[V]) SampleValues(
+	values []V,
+	k uint,
+) []V {
+	// Fill a reservoir with the first k values and then replace its values
+	// with decreasing probability.
+	var random = rnd.New(v.source_)
+	k = min(k, uint(len(values)))
+	var sample = make([]V, k)
+	copy(sample, values)
+	for index := int(k); index < len(values); index++ {
+		var slot = random.IntN(index + 1)
+		if slot < int(k) {
+			sample[slot] = values[index]
+		}
+	}
+
+	// Make sure the order of the sample is random as well.
+	v.ShuffleValues(sample)
+	return sample
+}
+
+func (v *sorter_[V]) SampleWeightedValues(
+	values []V,
+	weights []float64,
+	k uint,
+) []V {
+	if len(weights) != len(values) {
+		var message = fmt.Sprintf(
+			"The number of weights %v must match the number of values %v.",
+			len(weights),
+			len(values),
+		)
+		panic(message)
+	}
+
+	// Assign each value a random key based on its weight and keep the values
+	// with the k greatest keys in a heap whose root has the least key.
+	var random = rnd.New(v.source_)
+	var keys = make([]float64, 0, k)
+	var indices = make([]int, 0, k)
+	for index, weight := range weights {
+		if !(weight >= 0) || mat.IsInf(weight, 1) {
+			var message = fmt.Sprintf(
+				"Each weight must be a finite non-negative number: %v",
+				weight,
+			)
+			panic(message)
+		}
+		if weight == 0 {
+			// A value with no weight is never chosen.
+			continue
+		}
+		var key = mat.Log(1-random.Float64()) / weight
+		if uint(len(keys)) < k {
+			keys = append(keys, key)
+			indices = append(indices, index)
+			v.siftUpKeys(keys, indices, len(keys)-1)
+		} else if k > 0 && key > keys[0] {
+			keys[0] = key
+			indices[0] = index
+			v.siftDownKeys(keys, indices, 0, len(keys))
+		}
+	}
+
+	// Remove the least key from the heap until it is empty, so the values with
+	// the greatest keys end up first.
+	var sample = make([]V, len(keys))
+	for last := len(keys) - 1; last >= 0; last-- {
+		sample[last] = values[indices[0]]
+		keys[0], keys[last] = keys[last], keys[0]
+		indices[0], indices[last] = indices[last], indices[0]
+		v.siftDownKeys(keys, indices, 0, last)
+	}
+	return sample
+}
+
+func (v *sorter_[V]) SelectValue(
+	values []V,
+	k uint,
+) V {
+	var size = uint(len(values))
+	if k == 0 || k > size {
+		var message = fmt.Sprintf(
+			"The rank %v must be in the range [1..%v].",
+			k,
+			size,
+		)
+		panic(message)
+	}
+	v.selectValue(values, int(k-1))
+	return values[k-1]
+}
+
+func (v *sorter_[V]) PartialSort(
+	values []V,
+	k uint,
+) {
+	// Move the values ranked first to the front and then sort only them.
+	var size = uint(len(values))
+	if k == 0 {
+		return
+	}
+	if k < size {
+		v.SelectValue(values, k)
+		values = values[:k]
+	}
+	v.SortValues(values)
+}
+
+func (v *sorter_[V]) TopValues(
+	values []V,
+	k uint,
+) []V {
+	// Maintain a heap of the values ranked first whose root is the worst of
+	// them, replacing its root whenever a better value is found.
+	k = min(k, uint(len(values)))
+	var heap = make([]V, 0, k)
+	var indices = make([]int, 0, k)
+	for index, value := range values {
+		if uint(len(heap)) < k {
+			heap = append(heap, value)
+			indices = append(indices, index)
+			v.siftUpHeap(heap, indices, len(heap)-1)
+		} else if k > 0 && v.isLess(value, heap[0]) {
+			heap[0] = value
+			indices[0] = index
+			v.siftDownHeap(heap, indices, 0, len(heap))
+		}
+	}
+
+	// Remove the worst value from the heap until it is empty.
+	var top = make([]V, len(heap))
+	for last := len(heap) - 1; last >= 0; last-- {
+		top[last] = heap[0]
+		heap[0], heap[last] = heap[last], heap[0]
+		indices[0], indices[last] = indices[last], indices[0]
+		v.siftDownHeap(heap, indices, 0, last)
+	}
+	return top
+}
+
 // Attribute Methods
 
 func (v *sorter_[V]) GetRanker() RankingFunction[V] {
 	return v.ranker_
 }
 
-// PROTECTED INTERFACE
+func (v *sorter_[V]) GetWorkers() uint {
+	return v.workers_
+}
 
-// Private Methods
+func (v *sorter_[V]) GetAlgorithm() Algorithm {
+	return v.algorithm_
+}
 
-func (v *sorter_[V]) randomizeIndex(
-	size int,
-) int {
-	// Generate a cryptographically secure random index in the range [0..size).
-	var random, err = ran.Int(ran.Reader, big.NewInt(int64(size)))
-	if err != nil {
-		// There was an issue with the underlying OS so time to...
-		panic("Unable to generate a random index:\n" + err.Error())
+func (v *sorter_[V]) GetRandomSource() rnd.Source {
+	return v.source_
+}
+
+// PROTECTED INTERFACE
+
+func (v Algorithm) String() string {
+	var source string
+	switch v {
+	case MergeSort:
+		source = "MergeSort"
+	case PdqSort:
+		source = "PdqSort"
+	case TimSort:
+		source = "TimSort"
+	case RadixSort:
+		source = "RadixSort"
+	case InsertionSort:
+		source = "InsertionSort"
 	}
-	return int(random.Int64())
+	return source
 }
 
+// Private Methods
+
 // NOTE:
 // This method, and the mergeArrays method, together sort the values in the
 // specified Go array in place using an iterative merge sort along with the
@@ -176,48 +425,927 @@ func (v *sorter_[V]) mergeArrays(
 	var rightIndex = 0
 	var rightLength = len(right)
 	var mergedIndex = 0
-	var mergedLength = len(merged)
 
-	// Work our way through filling the entire merged Go array.
-	for mergedIndex < mergedLength {
+	// Work our way through both Go arrays while they still have values.
+	for leftIndex < leftLength && rightIndex < rightLength {
+		// Copy the next smallest value to the merged Go array.  A value from
+		// the right Go array is only chosen when it is strictly smaller, which
+		// keeps the merge stable.
+		if v.ranker_(right[rightIndex], left[leftIndex]) == LesserRank {
+			merged[mergedIndex] = right[rightIndex]
+			rightIndex++
+		} else {
+			merged[mergedIndex] = left[leftIndex]
+			leftIndex++
+		}
+		mergedIndex++
+	}
 
-		// Check to see if both left and right Go arrays still have values.
-		if leftIndex < leftLength && rightIndex < rightLength {
+	// Copy the rest of whichever Go array remains to the merged Go array.
+	mergedIndex += copy(merged[mergedIndex:], left[leftIndex:])
+	copy(merged[mergedIndex:], right[rightIndex:])
+}
 
-			// Copy the next smallest value to the merged Go array.
-			if v.ranker_(left[leftIndex], right[rightIndex]) == LesserRank {
-				merged[mergedIndex] = left[leftIndex]
-				leftIndex++
-			} else {
-				merged[mergedIndex] = right[rightIndex]
-				rightIndex++
+// NOTE:
+// This method sorts the values in the specified Go array in place by dividing
+// it into one contiguous chunk per worker and sorting the chunks concurrently.
+// Adjacent pairs of sorted chunks are then merged concurrently, round by round,
+// until a single sorted chunk remains.  Since only adjacent chunks are merged,
+// and always in their original order, the sort remains stable.
+func (v *sorter_[V]) sortConcurrently(
+	values []V,
+) {
+	// Determine the boundaries of each chunk.
+	var length = len(values)
+	var workers = int(v.workers_)
+	var boundaries = make([]int, workers+1)
+	for index := range boundaries {
+		boundaries[index] = index * length / workers
+	}
+
+	// Sort each chunk concurrently.
+	var group syn.WaitGroup
+	for index := 0; index < workers; index++ {
+		var chunk = values[boundaries[index]:boundaries[index+1]]
+		group.Go(func() {
+			v.sortValues(chunk)
+		})
+	}
+	group.Wait()
+
+	// Merge adjacent pairs of chunks concurrently until only one remains.
+	var sorted = values
+	var buffer = make([]V, length)
+	for len(boundaries) > 2 {
+		var merged = []int{0}
+		for index := 0; index+1 < len(boundaries); index += 2 {
+			var left = boundaries[index]
+			if index+2 == len(boundaries) {
+				// The last chunk has no partner so it is carried over as is.
+				var right = boundaries[index+1]
+				copy(buffer[left:right], sorted[left:right])
+				merged = append(merged, right)
+				continue
 			}
+			var middle = boundaries[index+1]
+			var right = boundaries[index+2]
+			group.Go(func() {
+				v.mergeArrays(
+					sorted[left:middle],
+					sorted[middle:right],
+					buffer[left:right],
+				)
+			})
+			merged = append(merged, right)
+		}
+		group.Wait()
+		boundaries = merged
 
-		} else if leftIndex < leftLength {
-			// Copy the rest of the left Go array to the merged Go array.
-			copy(merged[mergedIndex:], left[leftIndex:])
-			leftIndex++
+		// Swap the two Go arrays.
+		sorted, buffer = buffer, sorted
+	}
 
+	// Make sure the original Go array holds the sorted values.
+	if &sorted[0] != &values[0] {
+		copy(values, sorted)
+	}
+}
+
+func (v *sorter_[V]) isLess(
+	first V,
+	second V,
+) bool {
+	return v.ranker_(first, second) == LesserRank
+}
+
+// This method sorts the values in the specified Go array in place using a
+// binary insertion sort, assuming that the values preceding the specified
+// offset are already sorted.  Each value is inserted after any equal values
+// that precede it, so the sort is stable.
+func (v *sorter_[V]) insertionSort(
+	values []V,
+	offset int,
+) {
+	for index := max(offset, 1); index < len(values); index++ {
+		var value = values[index]
+		var position = v.upperBound(values[:index], value)
+		copy(values[position+1:index+1], values[position:index])
+		values[position] = value
+	}
+}
+
+// This method returns the index of the first value in the specified sorted Go
+// array that is ranked after the specified value.
+func (v *sorter_[V]) upperBound(
+	values []V,
+	value V,
+) int {
+	var low = 0
+	var high = len(values)
+	for low < high {
+		var middle = int(uint(low+high) >> 1)
+		if v.isLess(value, values[middle]) {
+			high = middle
 		} else {
-			// Copy the rest of the right Go array to the merged Go array.
-			copy(merged[mergedIndex:], right[rightIndex:])
-			rightIndex++
+			low = middle + 1
+		}
+	}
+	return low
+}
+
+// This method returns the index of the first value in the specified sorted Go
+// array that is not ranked before the specified value.
+func (v *sorter_[V]) lowerBound(
+	values []V,
+	value V,
+) int {
+	var low = 0
+	var high = len(values)
+	for low < high {
+		var middle = int(uint(low+high) >> 1)
+		if v.isLess(values[middle], value) {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low
+}
+
+// NOTE:
+// This method, and the methods that it calls, together sort the values in the
+// specified Go array in place using a pattern-defeating quicksort along with
+// the ranking function associated with this sorter.  The algorithm is
+// documented here:
+//   - https://arxiv.org/pdf/2106.05123.pdf
+//
+// It results in unstable O[nlog(n)] time and O[log(n)] space performance, and
+// approaches O[n] time for Go arrays that are already sorted or reversed.
+func (v *sorter_[V]) pdqSort(
+	values []V,
+	first int,
+	last int,
+	limit int,
+) {
+	var wasBalanced = true
+	var wasPartitioned = true
+	for {
+		var length = last - first
+		if length <= sorterClass[V]().maximumSmall_ {
+			v.insertionSort(values[first:last], 1)
+			return
+		}
+
+		// Fall back on a heap sort if there have been too many bad pivots.
+		if limit == 0 {
+			v.heapSort(values[first:last])
+			return
+		}
+
+		// Break up any patterns that resulted in an unbalanced partition.
+		if !wasBalanced {
+			v.breakPatterns(values[first:last])
+			limit--
+		}
+
+		var pivot, hint = v.choosePivot(values, first, last)
+		if hint == GreaterRank {
+			// The values appear to be in descending order.
+			v.ReverseValues(values[first:last])
+			pivot = (last - 1) - (pivot - first)
+			hint = LesserRank
+		}
+
+		// The values may already be sorted.
+		if wasBalanced && wasPartitioned && hint == LesserRank {
+			if v.partialInsertionSort(values[first:last]) {
+				return
+			}
+		}
+
+		// If the preceding pivot equals this one all values equal to the pivot
+		// are already in place.
+		if first > 0 && !v.isLess(values[first-1], values[pivot]) {
+			first = v.partitionEqual(values, first, last, pivot)
+			continue
+		}
+
+		var middle, alreadyPartitioned = v.partition(values, first, last, pivot)
+		wasPartitioned = alreadyPartitioned
+
+		// Recurse into the smaller side and iterate over the larger side.
+		var leftLength = middle - first
+		var rightLength = last - middle
+		var balance = length / 8
+		if leftLength < rightLength {
+			wasBalanced = leftLength >= balance
+			v.pdqSort(values, first, middle, limit)
+			first = middle + 1
+		} else {
+			wasBalanced = rightLength >= balance
+			v.pdqSort(values, middle+1, last, limit)
+			last = middle
 		}
-		mergedIndex++
 	}
 }
 
+func (v *sorter_[V]) partition(
+	values []V,
+	first int,
+	last int,
+	pivot int,
+) (
+	middle int,
+	alreadyPartitioned bool,
+) {
+	values[first], values[pivot] = values[pivot], values[first]
+	var i = first + 1
+	var j = last - 1
+	for i <= j && v.isLess(values[i], values[first]) {
+		i++
+	}
+	for i <= j && !v.isLess(values[j], values[first]) {
+		j--
+	}
+	if i > j {
+		values[j], values[first] = values[first], values[j]
+		return j, true
+	}
+	values[i], values[j] = values[j], values[i]
+	i++
+	j--
+	for {
+		for i <= j && v.isLess(values[i], values[first]) {
+			i++
+		}
+		for i <= j && !v.isLess(values[j], values[first]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		values[i], values[j] = values[j], values[i]
+		i++
+		j--
+	}
+	values[j], values[first] = values[first], values[j]
+	return j, false
+}
+
+func (v *sorter_[V]) partitionEqual(
+	values []V,
+	first int,
+	last int,
+	pivot int,
+) int {
+	values[first], values[pivot] = values[pivot], values[first]
+	var i = first + 1
+	var j = last - 1
+	for {
+		for i <= j && !v.isLess(values[first], values[i]) {
+			i++
+		}
+		for i <= j && v.isLess(values[first], values[j]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		values[i], values[j] = values[j], values[i]
+		i++
+		j--
+	}
+	return i
+}
+
+// This method attempts to sort a nearly sorted Go array by moving a few out of
+// place values.  It returns true if the values end up sorted.
+func (v *sorter_[V]) partialInsertionSort(
+	values []V,
+) bool {
+	var maximumSteps = 5
+	var shortestShifting = 50
+	var length = len(values)
+	var i = 1
+	for step := 0; step < maximumSteps; step++ {
+		for i < length && !v.isLess(values[i], values[i-1]) {
+			i++
+		}
+		if i == length {
+			return true
+		}
+		if length < shortestShifting {
+			return false
+		}
+		values[i], values[i-1] = values[i-1], values[i]
+
+		// Shift the smaller value to the left.
+		for j := i - 1; j >= 1; j-- {
+			if !v.isLess(values[j], values[j-1]) {
+				break
+			}
+			values[j], values[j-1] = values[j-1], values[j]
+		}
+
+		// Shift the greater value to the right.
+		for j := i + 1; j < length; j++ {
+			if !v.isLess(values[j], values[j-1]) {
+				break
+			}
+			values[j], values[j-1] = values[j-1], values[j]
+		}
+	}
+	return false
+}
+
+// This method swaps a few values around to break up patterns that may have
+// resulted in poorly chosen pivots.
+func (v *sorter_[V]) breakPatterns(
+	values []V,
+) {
+	var length = len(values)
+	if length >= 8 {
+		var random = uint64(length)
+		var modulus = uint(1) << bit.Len(uint(length))
+		var index = (length/4)*2 - 1
+		for offset := 0; offset < 3; offset++ {
+			// Generate the next pseudo-random number using xorshift.
+			random ^= random << 13
+			random ^= random >> 7
+			random ^= random << 17
+			var other = int(uint(random) & (modulus - 1))
+			if other >= length {
+				other -= length
+			}
+			values[index-1+offset], values[other] = values[other], values[index-1+offset]
+		}
+	}
+}
+
+// This method chooses a pivot using the median of three (or the median of
+// three medians for longer ranges).  It also returns a hint about the ordering
+// of the values: LesserRank if they appear ascending, GreaterRank if they
+// appear descending and EqualRank otherwise.
+func (v *sorter_[V]) choosePivot(
+	values []V,
+	first int,
+	last int,
+) (
+	pivot int,
+	hint Rank,
+) {
+	var shortestNinther = 50
+	var maximumSwaps = 4 * 3
+	var length = last - first
+	var swaps int
+	var i = first + length/4*1
+	var j = first + length/4*2
+	var k = first + length/4*3
+	if length >= 8 {
+		if length >= shortestNinther {
+			i = v.median(values, i-1, i, i+1, &swaps)
+			j = v.median(values, j-1, j, j+1, &swaps)
+			k = v.median(values, k-1, k, k+1, &swaps)
+		}
+		j = v.median(values, i, j, k, &swaps)
+	}
+	switch swaps {
+	case 0:
+		return j, LesserRank
+	case maximumSwaps:
+		return j, GreaterRank
+	default:
+		return j, EqualRank
+	}
+}
+
+func (v *sorter_[V]) median(
+	values []V,
+	a int,
+	b int,
+	c int,
+	swaps *int,
+) int {
+	a, b = v.order(values, a, b, swaps)
+	b, c = v.order(values, b, c, swaps)
+	a, b = v.order(values, a, b, swaps)
+	return b
+}
+
+func (v *sorter_[V]) order(
+	values []V,
+	a int,
+	b int,
+	swaps *int,
+) (int, int) {
+	if v.isLess(values[b], values[a]) {
+		*swaps++
+		return b, a
+	}
+	return a, b
+}
+
+func (v *sorter_[V]) heapSort(
+	values []V,
+) {
+	var length = len(values)
+	for index := (length - 1) / 2; index >= 0; index-- {
+		v.siftDown(values, index, length)
+	}
+	for index := length - 1; index >= 0; index-- {
+		values[0], values[index] = values[index], values[0]
+		v.siftDown(values, 0, index)
+	}
+}
+
+func (v *sorter_[V]) siftDown(
+	values []V,
+	root int,
+	length int,
+) {
+	for {
+		var child = 2*root + 1
+		if child >= length {
+			return
+		}
+		if child+1 < length && v.isLess(values[child], values[child+1]) {
+			child++
+		}
+		if !v.isLess(values[root], values[child]) {
+			return
+		}
+		values[root], values[child] = values[child], values[root]
+		root = child
+	}
+}
+
+// NOTE:
+// This method, and the methods that it calls, together sort the values in the
+// specified Go array in place using a timsort along with the ranking function
+// associated with this sorter.  The algorithm is documented here:
+//   - https://en.wikipedia.org/wiki/Timsort
+//
+// Runs of values that are already in ascending (or strictly descending) order
+// are found and extended to a minimum length using a binary insertion sort.
+// The runs are then merged while maintaining the run length invariants on a
+// stack of runs.  This results in stable O[nlog(n)] time and O[n] space
+// performance, and approaches O[n] time for Go arrays made up of a few sorted
+// runs.
+func (v *sorter_[V]) timSort(
+	values []V,
+) {
+	var length = len(values)
+	if length < 2 {
+		return
+	}
+	var minimum = v.minimumRun(length)
+	var buffer = make([]V, 0, length/2+1)
+	var starts []int // The starting index of each pending run.
+	var position = 0
+	for position < length {
+		var end = v.findRun(values, position)
+		if end-position < minimum {
+			// Extend the run to the minimum length.
+			var forced = min(position+minimum, length)
+			v.insertionSort(values[position:forced], end-position)
+			end = forced
+		}
+		starts = append(starts, position)
+		position = end
+
+		// Restore the invariants on the lengths of the pending runs.
+		for len(starts) > 1 {
+			var count = len(starts)
+			var lengthOf = func(index int) int {
+				if index+1 < count {
+					return starts[index+1] - starts[index]
+				}
+				return position - starts[index]
+			}
+			var a = 0
+			if count >= 3 {
+				a = lengthOf(count - 3)
+			}
+			var b = lengthOf(count - 2)
+			var c = lengthOf(count - 1)
+			if (count >= 3 && a <= b+c) || (count >= 4 && lengthOf(count-4) <= a+b) {
+				if a < c {
+					starts = v.mergeRuns(values, starts, count-3, position, &buffer)
+				} else {
+					starts = v.mergeRuns(values, starts, count-2, position, &buffer)
+				}
+			} else if b <= c {
+				starts = v.mergeRuns(values, starts, count-2, position, &buffer)
+			} else {
+				break
+			}
+		}
+	}
+
+	// Merge any remaining runs.
+	for len(starts) > 1 {
+		starts = v.mergeRuns(values, starts, len(starts)-2, length, &buffer)
+	}
+}
+
+// This method returns the minimum length of a run for a Go array of the
+// specified length such that the number of runs is close to a power of two.
+func (v *sorter_[V]) minimumRun(
+	length int,
+) int {
+	var remainder = 0
+	for length >= 2*sorterClass[V]().minimumMerge_ {
+		remainder |= length & 1
+		length >>= 1
+	}
+	return length + remainder
+}
+
+// This method returns the end of the run of ordered values beginning at the
+// specified index.  A strictly descending run is reversed in place.
+func (v *sorter_[V]) findRun(
+	values []V,
+	start int,
+) int {
+	var length = len(values)
+	var end = start + 1
+	if end == length {
+		return end
+	}
+	if v.isLess(values[end], values[start]) {
+		for end < length && v.isLess(values[end], values[end-1]) {
+			end++
+		}
+		v.ReverseValues(values[start:end])
+	} else {
+		for end < length && !v.isLess(values[end], values[end-1]) {
+			end++
+		}
+	}
+	return end
+}
+
+// This method merges the pending run at the specified index with the run that
+// follows it, and returns the remaining pending runs.
+func (v *sorter_[V]) mergeRuns(
+	values []V,
+	starts []int,
+	index int,
+	position int,
+	buffer *[]V,
+) []int {
+	var first = starts[index]
+	var middle = starts[index+1]
+	var last = position
+	if index+2 < len(starts) {
+		last = starts[index+2]
+	}
+
+	// Values in the left run that precede the entire right run are in place.
+	first += v.upperBound(values[first:middle], values[middle])
+
+	// Values in the right run that follow the entire left run are in place.
+	if first < middle {
+		last = middle + v.lowerBound(values[middle:last], values[middle-1])
+
+		// Merge the remaining values using a copy of the remaining left run.
+		*buffer = append((*buffer)[:0], values[first:middle]...)
+		v.mergeArrays(*buffer, values[middle:last], values[first:last])
+	}
+	return append(starts[:index+1], starts[index+2:]...)
+}
+
+// NOTE:
+// This method rearranges the values in the specified Go array in place using
+// an introspective quickselect along with the ranking function associated
+// with this sorter.  The algorithm is documented here:
+//   - https://en.wikipedia.org/wiki/Introselect
+//
+// Afterward the value at the target index is the one that would be there if
+// the entire Go array were sorted.  The pivots are chosen the same way as for
+// a pattern-defeating quicksort, and a heap sort is used if too many pivots
+// turn out to be poor.  This results in O[n] average time performance with an
+// O[nlog(n)] worst case.
+func (v *sorter_[V]) selectValue(
+	values []V,
+	target int,
+) {
+	var first = 0
+	var last = len(values)
+	var limit = 2 * bit.Len(uint(last))
+	for last-first > sorterClass[V]().maximumSmall_ {
+		if limit == 0 {
+			v.heapSort(values[first:last])
+			return
+		}
+		limit--
+		var pivot, _ = v.choosePivot(values, first, last)
+
+		// If the preceding pivot equals this one all values equal to the pivot
+		// are already in place.
+		if first > 0 && !v.isLess(values[first-1], values[pivot]) {
+			var middle = v.partitionEqual(values, first, last, pivot)
+			if target < middle {
+				return
+			}
+			first = middle
+			continue
+		}
+
+		var middle, _ = v.partition(values, first, last, pivot)
+		switch {
+		case target < middle:
+			last = middle
+		case target > middle:
+			first = middle + 1
+		default:
+			return
+		}
+	}
+	v.insertionSort(values[first:last], 1)
+}
+
+// This method restores the heap ordering after the value at the specified
+// index has been added.  The worst value (the greatest ranked, or the latest
+// of equally ranked values) is kept at the root.
+func (v *sorter_[V]) siftUpHeap(
+	heap []V,
+	indices []int,
+	child int,
+) {
+	for child > 0 {
+		var parent = (child - 1) / 2
+		if !v.isWorse(heap, indices, child, parent) {
+			return
+		}
+		heap[parent], heap[child] = heap[child], heap[parent]
+		indices[parent], indices[child] = indices[child], indices[parent]
+		child = parent
+	}
+}
+
+// This method restores the heap ordering after the value at the specified
+// index has been replaced.
+func (v *sorter_[V]) siftDownHeap(
+	heap []V,
+	indices []int,
+	root int,
+	length int,
+) {
+	for {
+		var child = 2*root + 1
+		if child >= length {
+			return
+		}
+		if child+1 < length && v.isWorse(heap, indices, child+1, child) {
+			child++
+		}
+		if !v.isWorse(heap, indices, child, root) {
+			return
+		}
+		heap[root], heap[child] = heap[child], heap[root]
+		indices[root], indices[child] = indices[child], indices[root]
+		root = child
+	}
+}
+
+func (v *sorter_[V]) isWorse(
+	heap []V,
+	indices []int,
+	first int,
+	second int,
+) bool {
+	switch v.ranker_(heap[first], heap[second]) {
+	case GreaterRank:
+		return true
+	case EqualRank:
+		return indices[first] > indices[second]
+	default:
+		return false
+	}
+}
+
+// This method restores the heap ordering after the key at the specified index
+// has been added.  The least key is kept at the root.
+func (v *sorter_[V]) siftUpKeys(
+	keys []float64,
+	indices []int,
+	child int,
+) {
+	for child > 0 {
+		var parent = (child - 1) / 2
+		if keys[parent] <= keys[child] {
+			return
+		}
+		keys[parent], keys[child] = keys[child], keys[parent]
+		indices[parent], indices[child] = indices[child], indices[parent]
+		child = parent
+	}
+}
+
+// This method restores the heap ordering after the key at the specified index
+// has been replaced.
+func (v *sorter_[V]) siftDownKeys(
+	keys []float64,
+	indices []int,
+	root int,
+	length int,
+) {
+	for {
+		var child = 2*root + 1
+		if child >= length {
+			return
+		}
+		if child+1 < length && keys[child+1] < keys[child] {
+			child++
+		}
+		if keys[root] <= keys[child] {
+			return
+		}
+		keys[root], keys[child] = keys[child], keys[root]
+		indices[root], indices[child] = indices[child], indices[root]
+		root = child
+	}
+}
+
+// NOTE:
+// This method sorts the values in the specified Go array in place using a
+// stable least significant digit radix sort for integers and a stable most
+// significant digit radix sort for strings.  The algorithms are documented
+// here:
+//   - https://en.wikipedia.org/wiki/Radix_sort
+//
+// The values are sorted into their natural ordering without the use of the
+// ranking function.  This results in O[kn] time and O[n] space performance
+// where k is the size of the keys in bytes.  The sorted values are then checked
+// against the ranking function and if it does not agree with their natural
+// ordering (e.g. it is reversed) they are sorted again using a pdqsort.
+func (v *sorter_[V]) radixSort(
+	values []V,
+) {
+	var length = len(values)
+	var kind = ref.TypeFor[V]().Kind()
+	switch kind {
+	case ref.Int, ref.Int8, ref.Int16, ref.Int32, ref.Int64:
+		var keys = make([]uint64, length)
+		for index, value := range values {
+			// Flip the sign bit so that negative integers are ranked first.
+			keys[index] = uint64(ref.ValueOf(value).Int()) ^ (1 << 63)
+		}
+		v.radixSortIntegers(values, keys)
+	case ref.Uint, ref.Uint8, ref.Uint16, ref.Uint32, ref.Uint64, ref.Uintptr:
+		var keys = make([]uint64, length)
+		for index, value := range values {
+			keys[index] = ref.ValueOf(value).Uint()
+		}
+		v.radixSortIntegers(values, keys)
+	case ref.String:
+		var keys = make([]string, length)
+		for index, value := range values {
+			keys[index] = ref.ValueOf(value).String()
+		}
+		v.radixSortStrings(values, keys, make([]V, length), make([]string, length), 0)
+	default:
+		var message = fmt.Sprintf(
+			"The values of type %v cannot be sorted using a radix sort.",
+			ref.TypeFor[V](),
+		)
+		panic(message)
+	}
+
+	// Fall back to a pdqsort if the ranking function is not the natural one.
+	for index := 1; index < length; index++ {
+		if v.ranker_(values[index], values[index-1]) == LesserRank {
+			var limit = bit.Len(uint(length))
+			v.pdqSort(values, 0, length, limit)
+			return
+		}
+	}
+}
+
+func (v *sorter_[V]) radixSortIntegers(
+	values []V,
+	keys []uint64,
+) {
+	var length = len(values)
+	var valuesBuffer = make([]V, length)
+	var keysBuffer = make([]uint64, length)
+	var sorted = values
+	for shift := 0; shift < 64; shift += 8 {
+		// Count the number of keys with each digit.
+		var counts [257]int
+		for _, key := range keys {
+			counts[(key>>shift)&0xFF+1]++
+		}
+		if counts[(keys[0]>>shift)&0xFF+1] == length {
+			// All keys share this digit so this pass would not change anything.
+			continue
+		}
+
+		// Distribute the values by digit, preserving their relative order.
+		for digit := 1; digit < 257; digit++ {
+			counts[digit] += counts[digit-1]
+		}
+		for index, key := range keys {
+			var digit = (key >> shift) & 0xFF
+			keysBuffer[counts[digit]] = key
+			valuesBuffer[counts[digit]] = sorted[index]
+			counts[digit]++
+		}
+		keys, keysBuffer = keysBuffer, keys
+		sorted, valuesBuffer = valuesBuffer, sorted
+	}
+
+	// Make sure the original Go array holds the sorted values.
+	if length > 0 && &sorted[0] != &values[0] {
+		copy(values, sorted)
+	}
+}
+
+func (v *sorter_[V]) radixSortStrings(
+	values []V,
+	keys []string,
+	valuesBuffer []V,
+	keysBuffer []string,
+	depth int,
+) {
+	var length = len(values)
+	if length < 2 {
+		return
+	}
+
+	// Count the number of keys with each byte at this depth.  Keys that are
+	// too short to have a byte at this depth are counted first.
+	var counts [258]int
+	for _, key := range keys {
+		counts[v.byteAt(key, depth)+1]++
+	}
+	if counts[v.byteAt(keys[0], depth)+1] == length {
+		// All keys share this byte so move on to the next depth.
+		if len(keys[0]) > depth {
+			v.radixSortStrings(values, keys, valuesBuffer, keysBuffer, depth+1)
+		}
+		return
+	}
+
+	// Distribute the values by byte, preserving their relative order.
+	for digit := 1; digit < 258; digit++ {
+		counts[digit] += counts[digit-1]
+	}
+	var starts = counts
+	for index, key := range keys {
+		var digit = v.byteAt(key, depth)
+		keysBuffer[counts[digit]] = key
+		valuesBuffer[counts[digit]] = values[index]
+		counts[digit]++
+	}
+	copy(keys, keysBuffer[:length])
+	copy(values, valuesBuffer[:length])
+
+	// Sort each group of keys sharing a byte (longer keys only).
+	for digit := 1; digit < 257; digit++ {
+		var first = starts[digit]
+		var last = counts[digit]
+		v.radixSortStrings(
+			values[first:last],
+			keys[first:last],
+			valuesBuffer[first:last],
+			keysBuffer[first:last],
+			depth+1,
+		)
+	}
+}
+
+// This method returns the byte at the specified depth of the specified key
+// offset by one, or zero if the key is too short.
+func (v *sorter_[V]) byteAt(
+	key string,
+	depth int,
+) int {
+	if depth < len(key) {
+		return int(key[depth]) + 1
+	}
+	return 0
+}
+
 // Instance Structure
 
 type sorter_[V any] struct {
 	// Declare the instance attributes.
-	ranker_ RankingFunction[V]
+	ranker_    RankingFunction[V]
+	workers_   uint
+	algorithm_ Algorithm
+	source_    rnd.Source
 }
 
 // Class Structure
 
 type sorterClass_[V any] struct {
 	// Declare the class constants.
+	threshold_    int
+	minimumMerge_ int
+	maximumSmall_ int
+	source_       rnd.Source
 }
 
 // Class Reference
@@ -241,6 +1369,10 @@ func sorterClass[V any]() *sorterClass_[V] {
 		// Add a new bound class type.
 		class = &sorterClass_[V]{
 			// Initialize the class constants.
+			threshold_:    4096,
+			minimumMerge_: 32,
+			maximumSmall_: 12,
+			source_:       cryptoSource_{},
 		}
 		sorterMap_[name] = class
 	}
@@ -249,3 +1381,23 @@ func sorterClass[V any]() *sorterClass_[V] {
 	// Return a reference to the bound class type.
 	return class
 }
+
+/*
+NOTE:
+The following is a private implementation of a random source that draws each of
+its random numbers from the cryptographically secure random number generator
+provided by the underlying OS.  It is used by default so that shuffles and
+samples are unpredictable, and it is safe for concurrent use.
+*/
+
+type cryptoSource_ struct{}
+
+func (v cryptoSource_) Uint64() uint64 {
+	var bytes [8]byte
+	var _, err = ran.Read(bytes[:])
+	if err != nil {
+		// There was an issue with the underlying OS so time to...
+		panic("Unable to generate a random number:\n" + err.Error())
+	}
+	return bin.LittleEndian.Uint64(bytes[:])
+}
@@ -29,10 +29,40 @@ on interfaces, not on each other.
 */
 package agents
 
-import ()
+import (
+	io "io"
+	rnd "math/rand/v2"
+)
 
 // TYPE DECLARATIONS
 
+/*
+Algorithm is a constrained type representing the sorting algorithms that are
+supported by a sorter.
+*/
+type Algorithm uint8
+
+const (
+	MergeSort Algorithm = iota
+	PdqSort
+	TimSort
+	RadixSort
+	InsertionSort
+)
+
+/*
+Operation is a constrained type representing the kinds of edits that can be
+made to a sequence of values.
+*/
+type Operation uint8
+
+const (
+	KeepEdit Operation = iota
+	InsertEdit
+	RemoveEdit
+	ChangeEdit
+)
+
 /*
 Rank is a constrained type representing the possible rankings for two values.
 */
@@ -46,6 +76,15 @@ const (
 
 // FUNCTIONAL DECLARATIONS
 
+/*
+ExtractorFunction[V any, K any] is a functional type that declares the signature
+for any function that can extract the key of a value that should be ranked or
+matched.
+*/
+type ExtractorFunction[V any, K any] func(
+	value V,
+) K
+
 /*
 RankingFunction[V any] is a functional type that declares the signature for any
 function that can determine the relative ranking of two values.
@@ -66,7 +105,8 @@ A collator-like class is capable of recursively comparing and ranking two values
 of any type.  An optional maximum depth may be specified that limits the depth
 of the structures being collated to avoid possible infinite recursion.
 
-The default maximum depth is 16.
+The default maximum depth is 16.  A collator may be shared by concurrent
+goroutines.
 */
 type CollatorClassLike[V any] interface {
 	// Constructor Methods
@@ -76,6 +116,223 @@ type CollatorClassLike[V any] interface {
 	) CollatorLike[V]
 }
 
+/*
+DecoderClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+decoder-like class.
+
+A decoder-like class reads values from a binary stream that was written by an
+encoder.  Each value is decoded directly from the stream so an arbitrarily
+large collection can be decoded without first reading the entire stream into
+memory.  If the specified reader is not a byte reader it is buffered, so the
+decoder may read past the end of the last value that it decodes.
+*/
+type DecoderClassLike interface {
+	// Constructor Methods
+	Decoder(
+		reader io.Reader,
+	) DecoderLike
+}
+
+/*
+DifferClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete differ-like class.
+
+A differ-like class finds the differences between two Go arrays of values as a
+Go array of edits, and can then apply those edits to a Go array of values.  A
+collator is used to determine whether or not two values are equal.  If no
+collator is specified, a collator with the default maximum depth is used.  A
+differ supports two kinds of differences:
+  - DiffValues() returns the shortest edit script (using the Myers algorithm)
+    that transforms the first sequence of values into the second one.  The
+    script contains a KeepEdit, InsertEdit or RemoveEdit for each value in the
+    order that they must be applied.  PatchValues() applies such a script.
+  - DiffMembers() treats the values as members of sets and returns a RemoveEdit
+    for each member that is only in the first Go array and an InsertEdit for
+    each member that is only in the second one, in ranked order.  PatchMembers()
+    applies these edits, with inserted members being appended.
+
+Any attempt to apply edits that do not match the values will result in a panic.
+FormatEdits() returns a readable rendering of edits with one edit per line.
+*/
+type DifferClassLike[V any] interface {
+	// Constructor Methods
+	Differ() DifferLike[V]
+	DifferWithCollator(
+		collator CollatorLike[V],
+	) DifferLike[V]
+}
+
+/*
+EncoderClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+encoder-like class.
+
+An encoder-like class writes values to a compact, versioned binary stream that
+can be read by a decoder.  Each value is encoded directly to the stream so an
+arbitrarily large collection can be encoded without first materializing it.
+The following values are supported:
+  - intrinsic values (booleans, numbers, runes, bytes and strings)
+  - Go arrays, slices, maps and pointers to supported values
+  - structures with exported attributes that are supported values
+  - instances of serializable classes (which may contain other instances)
+
+A value whose type is an interface can only be decoded if the concrete type of
+that value has been registered using RegisterType().  Each serializable class
+in this Go module registers its own instance type when the class is first
+accessed (e.g. ListClass[int]()) so the classes of any nested collections must
+be accessed before the collections are decoded.
+
+A collator is encoded by the name that it was registered with using
+RegisterCollator() and is decoded as the collator registered with that name.
+An unregistered collator is decoded as a default collator.
+*/
+type EncoderClassLike interface {
+	// Constructor Methods
+	Encoder(
+		writer io.Writer,
+	) EncoderLike
+
+	// Function Methods
+	RegisterType(
+		value any,
+	)
+	RegisterCollator(
+		name string,
+		collator any,
+	)
+}
+
+/*
+EditClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete edit-like class.
+
+An edit-like class describes a single edit that is made to a sequence of values.
+The previous value is only meaningful for a ChangeEdit, which replaces the
+previous value with the value.
+*/
+type EditClassLike[V any] interface {
+	// Constructor Methods
+	Edit(
+		operation Operation,
+		value V,
+	) EditLike[V]
+	EditWithPrevious(
+		operation Operation,
+		value V,
+		previous V,
+	) EditLike[V]
+}
+
+/*
+KeyDifferClassLike[V any, K comparable] is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete key-differ-like class.
+
+A key-differ-like class finds the differences between two Go arrays of values
+where each value has a unique key of type K that is found using an extractor
+function (e.g. the key of an association in a catalog).  A collator is used to
+determine whether or not two values having the same key are equal.  If no
+collator is specified, a collator with the default maximum depth is used.
+
+DiffValues() returns a RemoveEdit for each key that is only in the first Go
+array, a ChangeEdit for each key whose value differs, and an InsertEdit for each
+key that is only in the second Go array.  PatchValues() applies these edits,
+with inserted values being appended.  Each Go array is indexed by its keys once
+so both methods take O[n+m] time.  Any attempt to diff or patch a Go array that
+contains the same key more than once, or to apply edits that do not match the
+values, will result in a panic.
+*/
+type KeyDifferClassLike[V any, K comparable] interface {
+	// Constructor Methods
+	KeyDiffer(
+		extractor ExtractorFunction[V, K],
+	) KeyDifferLike[V, K]
+	KeyDifferWithCollator(
+		extractor ExtractorFunction[V, K],
+		collator CollatorLike[V],
+	) KeyDifferLike[V, K]
+}
+
+/*
+KeyRankerClassLike[V any, K any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete key-ranker-like class.
+
+A key-ranker-like class provides class functions that build new ranking
+functions for values of type V that rank each value by a key of type K that is
+extracted from it.  The following class functions are supported:
+
+RankBy() returns a ranking function that ranks two values by collating the keys
+returned by the specified extractor function using the default collator for
+the key type.
+
+RankByWithRanker() returns a ranking function that ranks two values by ranking
+the keys returned by the specified extractor function using the specified
+ranking function.
+*/
+type KeyRankerClassLike[V any, K any] interface {
+	// Function Methods
+	RankBy(
+		extractor ExtractorFunction[V, K],
+	) RankingFunction[V]
+	RankByWithRanker(
+		extractor ExtractorFunction[V, K],
+		ranker RankingFunction[K],
+	) RankingFunction[V]
+}
+
+/*
+RankerClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete ranker-like class.
+
+A ranker-like class provides class functions that build new ranking functions,
+often by combining existing ones.  The resulting ranking functions may be used
+anywhere a ranking function is expected (e.g. with SortValuesWithRanker() or
+SorterWithRanker()).  The following class functions are supported:
+
+ThenBy() returns a ranking function that ranks two values using the first
+ranking function, and then for values that are equal, the second one.
+
+Reversed() returns a ranking function that ranks two values in the reverse
+order of the specified ranking function.
+
+NilsFirst() and NilsLast() return ranking functions that rank nil values before
+(or after) all other values and rank the rest using the specified ranking
+function.
+
+IgnoringCase() returns a ranking function for string values that ignores the
+case of their characters.
+
+Naturally() returns a ranking function for string values that ignores the case
+of their characters and ranks each run of digits by its numeric value, so that
+"file2" is ranked before "file10".
+
+Any attempt to build a ranking function for string values when the values are
+not strings will result in a panic.
+*/
+type RankerClassLike[V any] interface {
+	// Function Methods
+	ThenBy(
+		first RankingFunction[V],
+		second RankingFunction[V],
+	) RankingFunction[V]
+	Reversed(
+		ranker RankingFunction[V],
+	) RankingFunction[V]
+	NilsFirst(
+		ranker RankingFunction[V],
+	) RankingFunction[V]
+	NilsLast(
+		ranker RankingFunction[V],
+	) RankingFunction[V]
+	IgnoringCase() RankingFunction[V]
+	Naturally() RankingFunction[V]
+}
+
 /*
 SorterClassLike[V any] is a class interface that declares the complete set
 of class constructors, constants and functions that must be supported by each
@@ -83,7 +340,57 @@ concrete sorter-like class.
 
 A sorter-like class implements a specific sorting algorithm.  It uses a ranking
 function to correlate the values.  If no ranking function is specified the
-values are sorted into their "natural" ordering by type of value.
+values are sorted into their "natural" ordering by type of value.  Sorting is
+stable, so values with an equal ranking retain their original relative order.
+
+A sorter may also be given a number of workers that sort large Go arrays
+concurrently.  Each worker sorts a contiguous chunk of the Go array and the
+sorted chunks are then merged pairwise.  Go arrays that are smaller than an
+internal threshold are sorted sequentially since the coordination overhead would
+outweigh the benefit.  The ranking function used by a concurrent sorter must be
+safe for concurrent use (the ranking function of a collator is).  If zero
+workers are specified the number of available processors is used.
+
+A sorter uses a merge sort by default but any of the following algorithms may
+be selected instead:
+  - MergeSort: a stable O[nlog(n)] merge sort that may be run concurrently.
+  - PdqSort: a pattern-defeating quicksort that is unstable and sorts in place.
+  - TimSort: a stable merge sort that adapts to runs of already sorted values.
+  - RadixSort: a stable radix sort for intrinsic integer and string types.
+  - InsertionSort: a stable insertion sort for very small Go arrays.
+
+A radix sort orders intrinsic integer and string values into their natural
+ordering directly and only consults the ranking function to verify the result.
+If the ranking function does not agree with the natural ordering (e.g. it is
+reversed) the values are sorted again using an unstable pdqsort.  Any attempt
+to radix sort values of some other type will result in a panic.  Only a merge sort
+makes use of multiple workers.
+
+A sorter can also avoid sorting an entire Go array when only some of its values
+are needed.  The rank k passed to each of the following methods is ordinal, so
+the first ranked value has a rank of 1:
+  - SelectValue() rearranges the values in place (using a quickselect) so that
+    the value with rank k is in position k, with no greater value before it and
+    no lesser value after it.  It then returns that value.
+  - PartialSort() rearranges the values in place so that the k values ranked
+    first are in sorted order at the start of the Go array.  The order of the
+    remaining values is unspecified.
+  - TopValues() returns a new Go array containing the k values ranked first in
+    sorted order (using a bounded heap) and leaves the values unchanged.  Equal
+    values are returned in their original relative order.
+
+A sorter draws the random numbers that it uses to shuffle and sample values
+from a cryptographically secure random source by default.  A seeded random
+source (e.g. rnd.NewPCG(1, 2)) may be specified instead so that the results
+can be reproduced.  A seeded random source is not safe for concurrent use.
+  - ShuffleValues() rearranges the values in place into a random order with
+    each possible order being equally likely (a Fisher-Yates shuffle).
+  - SampleValues() returns k values chosen at random (without replacement) in
+    random order and leaves the values unchanged.
+  - SampleWeightedValues() returns k values chosen at random (without
+    replacement) where the probability of choosing each value is proportional
+    to its weight.  The values are ordered as they were chosen, so values with
+    greater weights tend to be first.  A value with zero weight is never chosen.
 */
 type SorterClassLike[V any] interface {
 	// Constructor Methods
@@ -91,6 +398,18 @@ type SorterClassLike[V any] interface {
 	SorterWithRanker(
 		ranker RankingFunction[V],
 	) SorterLike[V]
+	SorterWithParallelism(
+		ranker RankingFunction[V],
+		workers uint,
+	) SorterLike[V]
+	SorterWithAlgorithm(
+		ranker RankingFunction[V],
+		algorithm Algorithm,
+	) SorterLike[V]
+	SorterWithRandomSource(
+		ranker RankingFunction[V],
+		source rnd.Source,
+	) SorterLike[V]
 }
 
 // INSTANCE DECLARATIONS
@@ -116,6 +435,123 @@ type CollatorLike[V any] interface {
 	GetMaximumDepth() uint
 }
 
+/*
+DecoderLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete decoder-like class.
+
+The DecodeValue() method decodes the next value from the stream into the value
+that the target points to.  Once an error has occurred each subsequent call
+returns the same error.  The ReportError() method allows a serializable value
+to reject the state that it decoded (e.g. from a corrupt stream) so that the
+error is returned by DecodeValue() rather than causing a panic.
+*/
+type DecoderLike interface {
+	// Principal Methods
+	GetClass() DecoderClassLike
+	DecodeValue(
+		target any,
+	) error
+	DecodeCollator() any
+	ReportError(
+		err error,
+	)
+
+	// Attribute Methods
+	GetVersion() uint8
+}
+
+/*
+DifferLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete differ-like class.
+*/
+type DifferLike[V any] interface {
+	// Principal Methods
+	GetClass() DifferClassLike[V]
+	DiffValues(
+		first []V,
+		second []V,
+	) []EditLike[V]
+	PatchValues(
+		values []V,
+		edits []EditLike[V],
+	) []V
+	DiffMembers(
+		first []V,
+		second []V,
+	) []EditLike[V]
+	PatchMembers(
+		values []V,
+		edits []EditLike[V],
+	) []V
+	FormatEdits(
+		edits []EditLike[V],
+	) string
+
+	// Attribute Methods
+	GetCollator() CollatorLike[V]
+}
+
+/*
+EncoderLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete encoder-like class.
+
+The EncodeValue() method encodes the specified value to the stream.  Once an
+error has occurred each subsequent call returns the same error.
+*/
+type EncoderLike interface {
+	// Principal Methods
+	GetClass() EncoderClassLike
+	EncodeValue(
+		value any,
+	) error
+	EncodeCollator(
+		collator any,
+	)
+
+	// Attribute Methods
+	GetVersion() uint8
+}
+
+/*
+EditLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete edit-like class.
+*/
+type EditLike[V any] interface {
+	// Principal Methods
+	GetClass() EditClassLike[V]
+
+	// Attribute Methods
+	GetOperation() Operation
+	GetValue() V
+	GetPrevious() V
+}
+
+/*
+KeyDifferLike[V any, K comparable] is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete key-differ-like class.
+*/
+type KeyDifferLike[V any, K comparable] interface {
+	// Principal Methods
+	GetClass() KeyDifferClassLike[V, K]
+	DiffValues(
+		first []V,
+		second []V,
+	) []EditLike[V]
+	PatchValues(
+		values []V,
+		edits []EditLike[V],
+	) []V
+
+	// Attribute Methods
+	GetExtractor() ExtractorFunction[V, K]
+	GetCollator() CollatorLike[V]
+}
+
 /*
 SorterLike[V any] is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
@@ -133,9 +569,52 @@ type SorterLike[V any] interface {
 	ShuffleValues(
 		values []V,
 	)
+	SampleValues(
+		values []V,
+		k uint,
+	) []V
+	SampleWeightedValues(
+		values []V,
+		weights []float64,
+		k uint,
+	) []V
+	SelectValue(
+		values []V,
+		k uint,
+	) V
+	PartialSort(
+		values []V,
+		k uint,
+	)
+	TopValues(
+		values []V,
+		k uint,
+	) []V
 
 	// Attribute Methods
 	GetRanker() RankingFunction[V]
+	GetWorkers() uint
+	GetAlgorithm() Algorithm
+	GetRandomSource() rnd.Source
 }
 
 // ASPECT DECLARATIONS
+
+/*
+Serializable is an aspect interface that declares a set of method signatures
+that must be supported by each instance of a serializable concrete class.
+
+The EncodeValues() method encodes the state of the instance using the specified
+encoder, and the DecodeValues() method replaces the state of the instance with
+the state decoded using the specified decoder.  Any errors are reported by the
+encoder or decoder, and the state of the instance is left unchanged if the
+decoded state is invalid.
+*/
+type Serializable interface {
+	EncodeValues(
+		encoder EncoderLike,
+	)
+	DecodeValues(
+		decoder DecoderLike,
+	)
+}
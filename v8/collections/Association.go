@@ -14,6 +14,7 @@ package collections
 
 import (
 	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
 	uti "github.com/craterdog/go-missing-utilities/v8"
 	syn "sync"
 )
@@ -50,6 +51,28 @@ func (c *associationClass_[K, V]) Association(
 
 // Function Methods
 
+func (c *associationClass_[K, V]) RankByKey(
+	ranker age.RankingFunction[K],
+) age.RankingFunction[AssociationLike[K, V]] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(first, second AssociationLike[K, V]) age.Rank {
+		return ranker(first.GetKey(), second.GetKey())
+	}
+}
+
+func (c *associationClass_[K, V]) RankByValue(
+	ranker age.RankingFunction[V],
+) age.RankingFunction[AssociationLike[K, V]] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	return func(first, second AssociationLike[K, V]) age.Rank {
+		return ranker(first.GetValue(), second.GetValue())
+	}
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -77,6 +100,27 @@ func (v *association_[K, V]) SetValue(
 	v.value_ = value
 }
 
+// Serializable Methods
+
+func (v *association_[K, V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeValue(v.key_)
+	encoder.EncodeValue(v.value_)
+}
+
+func (v *association_[K, V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var key K
+	var value V
+	if decoder.DecodeValue(&key) != nil || decoder.DecodeValue(&value) != nil {
+		return
+	}
+	v.key_ = key
+	v.value_ = value
+}
+
 // PROTECTED INTERFACE
 
 func (v *association_[K, V]) String() string {
@@ -125,6 +169,9 @@ func associationClass[K comparable, V any]() *associationClass_[K, V] {
 			// Initialize the class constants.
 		}
 		associationMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&association_[K, V]{})
 	}
 	associationMutex_.Unlock()
 
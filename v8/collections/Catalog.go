@@ -16,6 +16,7 @@ import (
 	fmt "fmt"
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	uti "github.com/craterdog/go-missing-utilities/v8"
+	rnd "math/rand/v2"
 	syn "sync"
 )
 
@@ -30,9 +31,8 @@ func CatalogClass[K comparable, V any]() CatalogClassLike[K, V] {
 // Constructor Methods
 
 func (c *catalogClass_[K, V]) Catalog() CatalogLike[K, V] {
-	var listClass = ListClass[AssociationLike[K, V]]()
-	var keys = map[K]AssociationLike[K, V]{}
-	var associations = listClass.List()
+	var keys = map[K]int{}
+	var associations = []AssociationLike[K, V]{}
 	var instance = &catalog_[K, V]{
 		// Initialize the instance attributes.
 		keys_:         keys,
@@ -116,6 +116,18 @@ func (c *catalogClass_[K, V]) Merge(
 	return catalog
 }
 
+func (c *catalogClass_[K, V]) ReadOnly(
+	catalog CatalogLike[K, V],
+) CatalogViewLike[K, V] {
+	if uti.IsUndefined(catalog) {
+		panic("The \"catalog\" attribute is required by this class.")
+	}
+	var view = &catalogView_[K, V]{
+		catalog_: catalog,
+	}
+	return view
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -124,18 +136,26 @@ func (v *catalog_[K, V]) GetClass() CatalogClassLike[K, V] {
 	return catalogClass[K, V]()
 }
 
+func (v *catalog_[K, V]) Begin() CatalogTransactionLike[K, V] {
+	return v.newTransaction(false)
+}
+
+func (v *catalog_[K, V]) BeginWithHistory() CatalogTransactionLike[K, V] {
+	return v.newTransaction(true)
+}
+
 // Attribute Methods
 
 // Associative[K, V] Methods
 
 func (v *catalog_[K, V]) AsMap() map[K]V {
 	var map_ = map[K]V{}
-	var iterator = v.associations_.GetIterator()
-	for iterator.HasNext() {
-		var association = iterator.GetNext()
-		var key = association.GetKey()
-		var value = association.GetValue()
-		map_[key] = value
+	for _, association := range v.associations_ {
+		if association != nil {
+			var key = association.GetKey()
+			var value = association.GetValue()
+			map_[key] = value
+		}
 	}
 	return map_
 }
@@ -144,10 +164,10 @@ func (v *catalog_[K, V]) GetValue(
 	key K,
 ) V {
 	var value V // Set the return value to its zero value.
-	var association, exists = v.keys_[key]
+	var slot, exists = v.keys_[key]
 	if exists {
 		// Extract the value.
-		value = association.GetValue()
+		value = v.associations_[slot].GetValue()
 	}
 	return value
 }
@@ -156,26 +176,47 @@ func (v *catalog_[K, V]) SetValue(
 	key K,
 	value V,
 ) {
-	var association, exists = v.keys_[key]
+	v.checkUnlocked()
+	var associationClass = AssociationClass[K, V]()
+	var slot, exists = v.keys_[key]
 	if exists {
 		// Set the value of an existing association.
+		var association = v.associations_[slot]
+		var previous = association.GetValue()
 		association.SetValue(value)
+		if v.observers_.isObserved() {
+			v.observers_.notify(
+				KeyUpdated,
+				0,
+				0,
+				[]AssociationLike[K, V]{associationClass.Association(key, value)},
+				[]AssociationLike[K, V]{associationClass.Association(key, previous)},
+			)
+		}
 	} else {
 		// Add a new association.
-		var associationClass = AssociationClass[K, V]()
-		association = associationClass.Association(key, value)
-		v.associations_.AppendValue(association)
-		v.keys_[key] = association
+		var association = associationClass.Association(key, value)
+		v.keys_[key] = len(v.associations_)
+		v.associations_ = append(v.associations_, association)
+		if v.observers_.isObserved() {
+			v.observers_.notify(
+				KeyAdded,
+				0,
+				0,
+				[]AssociationLike[K, V]{associationClass.Association(key, value)},
+				nil,
+			)
+		}
 	}
 }
 
 func (v *catalog_[K, V]) GetKeys() Sequential[K] {
 	var listClass = ListClass[K]()
 	var keys = listClass.List()
-	var iterator = v.associations_.GetIterator()
-	for iterator.HasNext() {
-		var association = iterator.GetNext()
-		keys.AppendValue(association.GetKey())
+	for _, association := range v.associations_ {
+		if association != nil {
+			keys.AppendValue(association.GetKey())
+		}
 	}
 	return keys
 }
@@ -196,13 +237,31 @@ func (v *catalog_[K, V]) GetValues(
 func (v *catalog_[K, V]) RemoveValue(
 	key K,
 ) V {
+	v.checkUnlocked()
 	var old V // Set the return value to its zero value.
-	var association, exists = v.keys_[key]
+	var slot, exists = v.keys_[key]
 	if exists {
-		var index = v.associations_.GetIndex(association)
-		v.associations_.RemoveValue(index)
+		// Leave a tombstone in place of the association so that the slots of
+		// the remaining associations are unaffected.
+		var association = v.associations_[slot]
 		old = association.GetValue()
+		v.associations_[slot] = nil
 		delete(v.keys_, key)
+		if v.observers_.isObserved() {
+			var associationClass = AssociationClass[K, V]()
+			v.observers_.notify(
+				KeyRemoved,
+				0,
+				0,
+				nil,
+				[]AssociationLike[K, V]{associationClass.Association(key, old)},
+			)
+		}
+
+		// Remove the tombstones once they outnumber the associations.
+		if 2*len(v.keys_) < len(v.associations_) {
+			v.removeTombstones()
+		}
 	}
 	return old
 }
@@ -210,60 +269,199 @@ func (v *catalog_[K, V]) RemoveValue(
 func (v *catalog_[K, V]) RemoveValues(
 	keys Sequential[K],
 ) Sequential[V] {
+	v.checkUnlocked()
 	var listClass = ListClass[V]()
 	var values = listClass.List()
-	var iterator = keys.GetIterator()
-	for iterator.HasNext() {
-		var key = iterator.GetNext()
-		values.AppendValue(v.RemoveValue(key))
-	}
+	v.observers_.batch(func() {
+		var iterator = keys.GetIterator()
+		for iterator.HasNext() {
+			var key = iterator.GetNext()
+			values.AppendValue(v.RemoveValue(key))
+		}
+	})
 	return values
 }
 
 func (v *catalog_[K, V]) RemoveAll() {
-	v.keys_ = map[K]AssociationLike[K, V]{}
-	v.associations_.RemoveAll()
+	v.checkUnlocked()
+	v.removeTombstones()
+	var removed = v.associations_
+	v.keys_ = map[K]int{}
+	v.associations_ = []AssociationLike[K, V]{}
+	if len(removed) > 0 && v.observers_.isObserved() {
+		v.observers_.notify(KeyRemoved, 0, 0, nil, v.copyAssociations(removed))
+	}
+}
+
+// Observable[AssociationLike[K, V]] Methods
+
+func (v *catalog_[K, V]) Subscribe(
+	listener ListenerFunction[AssociationLike[K, V]],
+) Subscription {
+	return v.observers_.subscribe(listener)
+}
+
+func (v *catalog_[K, V]) Batch(
+	function func(),
+) {
+	v.observers_.batch(function)
+}
+
+// Serializable Methods
+
+func (v *catalog_[K, V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	// The tombstones are skipped rather than removed since encoding a catalog
+	// must not change it.
+	encoder.EncodeValue(uint(len(v.keys_)))
+	for _, association := range v.associations_ {
+		if association != nil {
+			encoder.EncodeValue(association.GetKey())
+			encoder.EncodeValue(association.GetValue())
+		}
+	}
+}
+
+func (v *catalog_[K, V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	v.checkUnlocked()
+	var size uint
+	if decoder.DecodeValue(&size) != nil {
+		return
+	}
+	var associationClass = AssociationClass[K, V]()
+	var keys = map[K]int{}
+	var associations = []AssociationLike[K, V]{}
+	for range size {
+		var key K
+		var value V
+		if decoder.DecodeValue(&key) != nil || decoder.DecodeValue(&value) != nil {
+			return
+		}
+		var slot, exists = keys[key]
+		if exists {
+			associations[slot].SetValue(value)
+			continue
+		}
+		keys[key] = len(associations)
+		associations = append(associations, associationClass.Association(key, value))
+	}
+	v.keys_ = keys
+	v.associations_ = associations
 }
 
 // Sequential[AssociationLike[K, V]] Methods
 
 func (v *catalog_[K, V]) IsEmpty() bool {
-	return v.associations_.IsEmpty()
+	return len(v.keys_) == 0
 }
 
 func (v *catalog_[K, V]) GetSize() uint {
-	var size = v.associations_.GetSize()
+	var size = uint(len(v.keys_))
 	return size
 }
 
 func (v *catalog_[K, V]) AsArray() []AssociationLike[K, V] {
-	var array = v.associations_.AsArray()
+	var array = v.liveAssociations()
 	return array
 }
 
 func (v *catalog_[K, V]) GetIterator() uti.IteratorLike[AssociationLike[K, V]] {
-	var iterator = v.associations_.GetIterator()
+	var array = v.AsArray()
+	var iterator = uti.Iterator(array)
 	return iterator
 }
 
 // Sortable[AssociationLike[K, V]] Methods
 
 func (v *catalog_[K, V]) SortValues() {
-	v.associations_.SortValues()
+	v.checkUnlocked()
+	var sorter = age.SorterClass[AssociationLike[K, V]]().Sorter()
+	v.removeTombstones()
+	sorter.SortValues(v.associations_)
+	v.updateSlots()
+	v.notifyReordered()
 }
 
 func (v *catalog_[K, V]) SortValuesWithRanker(
 	ranker age.RankingFunction[AssociationLike[K, V]],
 ) {
-	v.associations_.SortValuesWithRanker(ranker)
+	v.checkUnlocked()
+	var sorter = age.SorterClass[AssociationLike[K, V]]().SorterWithRanker(ranker)
+	v.removeTombstones()
+	sorter.SortValues(v.associations_)
+	v.updateSlots()
+	v.notifyReordered()
 }
 
 func (v *catalog_[K, V]) ReverseValues() {
-	v.associations_.ReverseValues()
+	v.checkUnlocked()
+	var sorter = age.SorterClass[AssociationLike[K, V]]().Sorter()
+	v.removeTombstones()
+	sorter.ReverseValues(v.associations_)
+	v.updateSlots()
+	v.notifyReordered()
 }
 
 func (v *catalog_[K, V]) ShuffleValues() {
-	v.associations_.ShuffleValues()
+	v.checkUnlocked()
+	var sorter = age.SorterClass[AssociationLike[K, V]]().Sorter()
+	v.removeTombstones()
+	sorter.ShuffleValues(v.associations_)
+	v.updateSlots()
+	v.notifyReordered()
+}
+
+func (v *catalog_[K, V]) ShuffleValuesWithSource(
+	source rnd.Source,
+) {
+	v.checkUnlocked()
+	var ranker = age.CollatorClass[AssociationLike[K, V]]().Collator().RankValues
+	var sorter = age.SorterClass[AssociationLike[K, V]]().SorterWithRandomSource(
+		ranker,
+		source,
+	)
+	v.removeTombstones()
+	sorter.ShuffleValues(v.associations_)
+	v.updateSlots()
+	v.notifyReordered()
+}
+
+func (v *catalog_[K, V]) SelectValue(
+	k uint,
+	ranker age.RankingFunction[AssociationLike[K, V]],
+) AssociationLike[K, V] {
+	v.checkUnlocked()
+	var sorter = age.SorterClass[AssociationLike[K, V]]().SorterWithRanker(ranker)
+	v.removeTombstones()
+	var association = sorter.SelectValue(v.associations_, k)
+	v.updateSlots()
+	v.notifyReordered()
+	return association
+}
+
+func (v *catalog_[K, V]) PartialSort(
+	k uint,
+	ranker age.RankingFunction[AssociationLike[K, V]],
+) {
+	v.checkUnlocked()
+	var sorter = age.SorterClass[AssociationLike[K, V]]().SorterWithRanker(ranker)
+	v.removeTombstones()
+	sorter.PartialSort(v.associations_, k)
+	v.updateSlots()
+	v.notifyReordered()
+}
+
+func (v *catalog_[K, V]) TopValues(
+	k uint,
+	ranker age.RankingFunction[AssociationLike[K, V]],
+) Sequential[AssociationLike[K, V]] {
+	var sorter = age.SorterClass[AssociationLike[K, V]]().SorterWithRanker(ranker)
+	var associations = sorter.TopValues(v.liveAssociations(), k)
+	var listClass = ListClass[AssociationLike[K, V]]()
+	return listClass.ListFromArray(associations)
 }
 
 // PROTECTED INTERFACE
@@ -274,12 +472,111 @@ func (v *catalog_[K, V]) String() string {
 
 // Private Methods
 
+// This private instance method returns a new Go array containing the
+// associations in this catalog without the tombstones left behind by removed
+// associations.  Since it is used by the methods that only read the catalog,
+// the tombstones are skipped rather than removed.
+func (v *catalog_[K, V]) liveAssociations() []AssociationLike[K, V] {
+	var array = make([]AssociationLike[K, V], 0, len(v.keys_))
+	for _, association := range v.associations_ {
+		if association != nil {
+			array = append(array, association)
+		}
+	}
+	return array
+}
+
+// This private instance method removes the tombstones left behind by removed
+// associations, preserving the order of the remaining associations.  Since
+// the tombstones are only removed once they outnumber the associations, the
+// cost of removing them is amortized across the removals.
+func (v *catalog_[K, V]) removeTombstones() {
+	var size = len(v.keys_)
+	if size == len(v.associations_) {
+		// There are no tombstones.
+		return
+	}
+	var slot = 0
+	for _, association := range v.associations_ {
+		if association != nil {
+			v.associations_[slot] = association
+			slot++
+		}
+	}
+	clear(v.associations_[size:]) // Release the references for collection.
+	v.associations_ = v.associations_[:size]
+	v.updateSlots()
+}
+
+// This private instance method panics if a transaction is in progress on this
+// catalog, since any direct change to the catalog would be lost when it commits.
+func (v *catalog_[K, V]) checkUnlocked() {
+	if v.locked_ {
+		panic("The catalog cannot be changed directly while a transaction is in progress.")
+	}
+}
+
+// This private instance method updates the slot of each association after
+// the associations have been reordered.
+func (v *catalog_[K, V]) updateSlots() {
+	for slot, association := range v.associations_ {
+		v.keys_[association.GetKey()] = slot
+	}
+}
+
+// This private instance method returns a new transaction on this catalog that
+// makes its changes to an immutable copy of the catalog.  The catalog is locked
+// until the transaction is committed or rolled back.
+func (v *catalog_[K, V]) newTransaction(
+	history bool,
+) CatalogTransactionLike[K, V] {
+	if v.locked_ {
+		panic("A transaction is already in progress on this catalog.")
+	}
+	v.locked_ = true
+	var immutableClass = ImmutableCatalogClass[K, V]()
+	var transaction = &catalogTransaction_[K, V]{
+		catalog_: v,
+		staged_:  immutableClass.ImmutableCatalogFromSequence(v),
+		history_: history,
+	}
+	return transaction
+}
+
+// This private instance method returns copies of the specified associations.
+// Since associations are mutable, listeners are only ever passed copies of them.
+func (v *catalog_[K, V]) copyAssociations(
+	associations []AssociationLike[K, V],
+) []AssociationLike[K, V] {
+	var associationClass = AssociationClass[K, V]()
+	var copies = make([]AssociationLike[K, V], 0, len(associations))
+	for _, association := range associations {
+		var key = association.GetKey()
+		var value = association.GetValue()
+		copies = append(copies, associationClass.Association(key, value))
+	}
+	return copies
+}
+
+// This private instance method notifies any listeners that the associations
+// have been reordered.
+func (v *catalog_[K, V]) notifyReordered() {
+	var size = len(v.associations_)
+	if size == 0 || !v.observers_.isObserved() {
+		return
+	}
+	var associations = v.copyAssociations(v.associations_)
+	v.observers_.notify(Reordered, 1, size, associations, nil)
+}
+
 // Instance Structure
 
 type catalog_[K comparable, V any] struct {
 	// Declare the instance attributes.
-	associations_ ListLike[AssociationLike[K, V]]
-	keys_         map[K]AssociationLike[K, V]
+	associations_ []AssociationLike[K, V]
+	keys_         map[K]int
+	observers_    observers_[AssociationLike[K, V]]
+	locked_       bool
 }
 
 // Class Structure
@@ -311,9 +608,321 @@ func catalogClass[K comparable, V any]() *catalogClass_[K, V] {
 			// Initialize the class constants.
 		}
 		catalogMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&catalog_[K, V]{})
 	}
 	catalogMutex_.Unlock()
 
 	// Return a reference to the bound class type.
 	return class
 }
+
+/*
+NOTE:
+The following is a private implementation of a read-only view of a catalog.  It
+delegates each query to the catalog itself so that it always reflects the
+current state of the catalog.  Since associations can be changed, the view only
+returns copies of the associations in the catalog.
+*/
+
+type catalogView_[K comparable, V any] struct {
+	catalog_ CatalogLike[K, V]
+}
+
+func (v *catalogView_[K, V]) AsCatalog() CatalogLike[K, V] {
+	return catalogClass[K, V]().CatalogFromSequence(v.catalog_)
+}
+
+func (v *catalogView_[K, V]) AsMap() map[K]V {
+	return v.catalog_.AsMap()
+}
+
+func (v *catalogView_[K, V]) GetValue(
+	key K,
+) V {
+	return v.catalog_.GetValue(key)
+}
+
+func (v *catalogView_[K, V]) GetKeys() Sequential[K] {
+	return v.catalog_.GetKeys()
+}
+
+func (v *catalogView_[K, V]) GetValues(
+	keys Sequential[K],
+) Sequential[V] {
+	return v.catalog_.GetValues(keys)
+}
+
+func (v *catalogView_[K, V]) IsEmpty() bool {
+	return v.catalog_.IsEmpty()
+}
+
+func (v *catalogView_[K, V]) GetSize() uint {
+	return v.catalog_.GetSize()
+}
+
+func (v *catalogView_[K, V]) AsArray() []AssociationLike[K, V] {
+	var associationClass = AssociationClass[K, V]()
+	var array = v.catalog_.AsArray()
+	for index, association := range array {
+		var key = association.GetKey()
+		var value = association.GetValue()
+		array[index] = associationClass.Association(key, value)
+	}
+	return array
+}
+
+func (v *catalogView_[K, V]) GetIterator() uti.IteratorLike[AssociationLike[K, V]] {
+	var array = v.AsArray()
+	var iterator = uti.Iterator(array)
+	return iterator
+}
+
+func (v *catalogView_[K, V]) String() string {
+	return uti.Format(v)
+}
+
+/*
+NOTE:
+The following is a private implementation of a transaction on a catalog.  The
+changes made through the transaction are applied to a persistent immutable copy
+of the catalog, so each previous version of the copy can be retained cheaply as
+the history of the transaction.  When the transaction is committed, the events
+passed to the listeners of the catalog are found by comparing the catalog with
+the copy.
+*/
+
+type catalogTransaction_[K comparable, V any] struct {
+	catalog_ *catalog_[K, V]
+	staged_  ImmutableCatalogLike[K, V]
+	history_ bool
+	undos_   []ImmutableCatalogLike[K, V]
+	redos_   []ImmutableCatalogLike[K, V]
+	ended_   bool
+}
+
+func (v *catalogTransaction_[K, V]) Commit() {
+	v.end()
+	var catalog = v.catalog_
+	var events []EventLike[AssociationLike[K, V]]
+	if catalog.observers_.isObserved() {
+		events = v.findChanges()
+	}
+	var size = int(v.staged_.GetSize())
+	var associations = make([]AssociationLike[K, V], 0, size)
+	var keys = make(map[K]int, size)
+	var iterator = v.staged_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		keys[association.GetKey()] = len(associations)
+		associations = append(associations, association)
+	}
+	catalog.associations_ = associations
+	catalog.keys_ = keys
+	if len(events) > 0 {
+		catalog.observers_.publish(events)
+	}
+}
+
+func (v *catalogTransaction_[K, V]) Rollback() {
+	v.end()
+}
+
+func (v *catalogTransaction_[K, V]) Undo() {
+	v.checkActive()
+	var last = len(v.undos_) - 1
+	if last < 0 {
+		return
+	}
+	v.redos_ = append(v.redos_, v.staged_)
+	v.staged_ = v.undos_[last]
+	v.undos_ = v.undos_[:last]
+}
+
+func (v *catalogTransaction_[K, V]) Redo() {
+	v.checkActive()
+	var last = len(v.redos_) - 1
+	if last < 0 {
+		return
+	}
+	v.undos_ = append(v.undos_, v.staged_)
+	v.staged_ = v.redos_[last]
+	v.redos_ = v.redos_[:last]
+}
+
+func (v *catalogTransaction_[K, V]) CanUndo() bool {
+	return len(v.undos_) > 0
+}
+
+func (v *catalogTransaction_[K, V]) CanRedo() bool {
+	return len(v.redos_) > 0
+}
+
+func (v *catalogTransaction_[K, V]) AsMap() map[K]V {
+	v.checkActive()
+	return v.staged_.AsMap()
+}
+
+func (v *catalogTransaction_[K, V]) GetValue(
+	key K,
+) V {
+	v.checkActive()
+	return v.staged_.GetValue(key)
+}
+
+func (v *catalogTransaction_[K, V]) SetValue(
+	key K,
+	value V,
+) {
+	v.checkActive()
+	v.change(v.staged_.WithValue(key, value))
+}
+
+func (v *catalogTransaction_[K, V]) GetKeys() Sequential[K] {
+	v.checkActive()
+	return v.staged_.GetKeys()
+}
+
+func (v *catalogTransaction_[K, V]) GetValues(
+	keys Sequential[K],
+) Sequential[V] {
+	v.checkActive()
+	return v.staged_.GetValues(keys)
+}
+
+func (v *catalogTransaction_[K, V]) RemoveValue(
+	key K,
+) V {
+	v.checkActive()
+	var old = v.staged_.GetValue(key)
+	v.change(v.staged_.WithoutValue(key))
+	return old
+}
+
+func (v *catalogTransaction_[K, V]) RemoveValues(
+	keys Sequential[K],
+) Sequential[V] {
+	v.checkActive()
+	var values = ListClass[V]().List()
+	var staged = v.staged_
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		values.AppendValue(staged.GetValue(key))
+		staged = staged.WithoutValue(key)
+	}
+	v.change(staged)
+	return values
+}
+
+func (v *catalogTransaction_[K, V]) RemoveAll() {
+	v.checkActive()
+	v.change(ImmutableCatalogClass[K, V]().ImmutableCatalog())
+}
+
+func (v *catalogTransaction_[K, V]) IsEmpty() bool {
+	v.checkActive()
+	return v.staged_.IsEmpty()
+}
+
+func (v *catalogTransaction_[K, V]) GetSize() uint {
+	v.checkActive()
+	return v.staged_.GetSize()
+}
+
+func (v *catalogTransaction_[K, V]) AsArray() []AssociationLike[K, V] {
+	v.checkActive()
+	return v.staged_.AsArray()
+}
+
+func (v *catalogTransaction_[K, V]) GetIterator() uti.IteratorLike[AssociationLike[K, V]] {
+	v.checkActive()
+	return v.staged_.GetIterator()
+}
+
+func (v *catalogTransaction_[K, V]) String() string {
+	return uti.Format(v)
+}
+
+// This private method replaces the immutable copy of the catalog and, when
+// history is requested, retains the previous copy so that it can be restored.
+func (v *catalogTransaction_[K, V]) change(
+	staged ImmutableCatalogLike[K, V],
+) {
+	if staged == v.staged_ {
+		// Nothing was changed.
+		return
+	}
+	if v.history_ {
+		v.undos_ = append(v.undos_, v.staged_)
+		v.redos_ = nil
+	}
+	v.staged_ = staged
+}
+
+// This private method returns the events describing the net changes to the
+// keys of the catalog that will be made by committing this transaction.
+func (v *catalogTransaction_[K, V]) findChanges() []EventLike[AssociationLike[K, V]] {
+	var events []EventLike[AssociationLike[K, V]]
+	var observers = &v.catalog_.observers_
+	var associationClass = AssociationClass[K, V]()
+	var collator = age.CollatorClass[V]().Collator()
+	var staged = v.staged_.AsMap()
+	for _, association := range v.catalog_.associations_ {
+		if association == nil {
+			continue
+		}
+		var key = association.GetKey()
+		var previous = associationClass.Association(key, association.GetValue())
+		var value, exists = staged[key]
+		switch {
+		case !exists:
+			var event = observers.newEvent(
+				KeyRemoved,
+				0,
+				0,
+				nil,
+				[]AssociationLike[K, V]{previous},
+			)
+			events = append(events, event)
+		case !collator.CompareValues(association.GetValue(), value):
+			var event = observers.newEvent(
+				KeyUpdated,
+				0,
+				0,
+				[]AssociationLike[K, V]{associationClass.Association(key, value)},
+				[]AssociationLike[K, V]{previous},
+			)
+			events = append(events, event)
+		}
+	}
+	var iterator = v.staged_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var _, exists = v.catalog_.keys_[association.GetKey()]
+		if !exists {
+			var event = observers.newEvent(
+				KeyAdded,
+				0,
+				0,
+				[]AssociationLike[K, V]{association},
+				nil,
+			)
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (v *catalogTransaction_[K, V]) checkActive() {
+	if v.ended_ {
+		panic("The transaction has already been committed or rolled back.")
+	}
+}
+
+func (v *catalogTransaction_[K, V]) end() {
+	v.checkActive()
+	v.ended_ = true
+	v.catalog_.locked_ = false
+}
@@ -0,0 +1,302 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	sli "slices"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func EventClass[V any]() EventClassLike[V] {
+	return eventClass[V]()
+}
+
+// Constructor Methods
+
+func (c *eventClass_[V]) Event(
+	change Change,
+	first int,
+	last int,
+	values Sequential[V],
+	previous Sequential[V],
+) EventLike[V] {
+	if uti.IsUndefined(values) {
+		panic("The \"values\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(previous) {
+		panic("The \"previous\" attribute is required by this class.")
+	}
+	var instance = &event_[V]{
+		// Initialize the instance attributes.
+		change_:   change,
+		first_:    first,
+		last_:     last,
+		values_:   values,
+		previous_: previous,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *event_[V]) GetClass() EventClassLike[V] {
+	return eventClass[V]()
+}
+
+// Attribute Methods
+
+func (v *event_[V]) GetChange() Change {
+	return v.change_
+}
+
+func (v *event_[V]) GetFirst() int {
+	return v.first_
+}
+
+func (v *event_[V]) GetLast() int {
+	return v.last_
+}
+
+func (v *event_[V]) GetValues() Sequential[V] {
+	return v.values_
+}
+
+func (v *event_[V]) GetPrevious() Sequential[V] {
+	return v.previous_
+}
+
+// PROTECTED INTERFACE
+
+func (v *event_[V]) String() string {
+	return uti.Format(v)
+}
+
+// Private Methods
+
+// Instance Structure
+
+type event_[V any] struct {
+	// Declare the instance attributes.
+	change_   Change
+	first_    int
+	last_     int
+	values_   Sequential[V]
+	previous_ Sequential[V]
+}
+
+// Class Structure
+
+type eventClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var eventMap_ = map[string]any{}
+var eventMutex_ syn.Mutex
+
+func eventClass[V any]() *eventClass_[V] {
+	// Generate the name of the bound class type.
+	var class *eventClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	eventMutex_.Lock()
+	var value = eventMap_[name]
+	switch actual := value.(type) {
+	case *eventClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &eventClass_[V]{
+			// Initialize the class constants.
+		}
+		eventMap_[name] = class
+	}
+	eventMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
+
+/*
+NOTE:
+The following is a private implementation of the listeners that are subscribed
+to an observable collection.  Each observable collection contains one and uses
+it to implement the Observable[V] aspect.  No events are created unless there
+is at least one subscribed listener, so an unobserved collection pays almost
+nothing for being observable.
+*/
+
+type observers_[V any] struct {
+	subscriptions_ []*subscription_[V]
+	depth_         int
+	pending_       []EventLike[V]
+}
+
+func (v *observers_[V]) subscribe(
+	listener ListenerFunction[V],
+) Subscription {
+	if uti.IsUndefined(listener) {
+		panic("The \"listener\" attribute is required by this class.")
+	}
+	var subscription = &subscription_[V]{
+		observers_: v,
+		listener_:  listener,
+	}
+	v.subscriptions_ = append(v.subscriptions_, subscription)
+	return subscription
+}
+
+func (v *observers_[V]) batch(
+	function func(),
+) {
+	v.depth_++
+	var completed bool
+	defer func() {
+		v.depth_--
+		if v.depth_ > 0 {
+			return
+		}
+		var events = v.pending_
+		v.pending_ = nil
+		if completed && len(events) > 0 {
+			// The events are only delivered if the function returned normally.
+			v.deliver(events)
+		}
+	}()
+	function()
+	completed = true
+}
+
+func (v *observers_[V]) isObserved() bool {
+	return len(v.subscriptions_) > 0
+}
+
+// This private method notifies each listener of the specified change.  The
+// specified Go arrays are copied so the caller may reuse them.
+func (v *observers_[V]) notify(
+	change Change,
+	first int,
+	last int,
+	values []V,
+	previous []V,
+) {
+	if !v.isObserved() {
+		return
+	}
+	var event = v.newEvent(change, first, last, values, previous)
+	v.publish([]EventLike[V]{event})
+}
+
+// This private method returns a new event containing copies of the specified
+// Go arrays.
+func (v *observers_[V]) newEvent(
+	change Change,
+	first int,
+	last int,
+	values []V,
+	previous []V,
+) EventLike[V] {
+	var listClass = ImmutableListClass[V]()
+	var event = eventClass[V]().Event(
+		change,
+		first,
+		last,
+		listClass.ImmutableListFromArray(values),
+		listClass.ImmutableListFromArray(previous),
+	)
+	return event
+}
+
+// This private method delivers the specified events to each listener, or if a
+// batch is in progress, holds them until the batch is complete.
+func (v *observers_[V]) publish(
+	events []EventLike[V],
+) {
+	if v.depth_ > 0 {
+		v.pending_ = append(v.pending_, events...)
+		return
+	}
+	v.deliver(events)
+}
+
+func (v *observers_[V]) deliver(
+	events []EventLike[V],
+) {
+	var sequence = eventSequence_[V](events)
+	// A listener may cancel a subscription while it is being notified.
+	for _, subscription := range sli.Clone(v.subscriptions_) {
+		if subscription.IsActive() {
+			subscription.listener_(sequence)
+		}
+	}
+}
+
+// The events are passed to the listeners using a private sequence type.  A
+// generic collection of the events cannot be used since each observable
+// collection would then require a collection of its own events, resulting in
+// an endless chain of generic instantiations.
+type eventSequence_[V any] []EventLike[V]
+
+func (v eventSequence_[V]) IsEmpty() bool {
+	return len(v) == 0
+}
+
+func (v eventSequence_[V]) GetSize() uint {
+	return uti.ArraySize(v)
+}
+
+func (v eventSequence_[V]) AsArray() []EventLike[V] {
+	return uti.CopyArray(v)
+}
+
+func (v eventSequence_[V]) GetIterator() uti.IteratorLike[EventLike[V]] {
+	return uti.Iterator(uti.CopyArray(v))
+}
+
+type subscription_[V any] struct {
+	observers_ *observers_[V]
+	listener_  ListenerFunction[V]
+	canceled_  bool
+}
+
+func (v *subscription_[V]) Cancel() {
+	if v.canceled_ {
+		return
+	}
+	v.canceled_ = true
+	v.observers_.subscriptions_ = sli.DeleteFunc(
+		sli.Clone(v.observers_.subscriptions_),
+		func(subscription *subscription_[V]) bool {
+			return subscription == v
+		},
+	)
+}
+
+func (v *subscription_[V]) IsActive() bool {
+	return !v.canceled_
+}
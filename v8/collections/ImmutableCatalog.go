@@ -0,0 +1,450 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	has "hash/maphash"
+	bit "math/bits"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ImmutableCatalogClass[K comparable, V any]() ImmutableCatalogClassLike[K, V] {
+	return immutableCatalogClass[K, V]()
+}
+
+// Constructor Methods
+
+func (c *immutableCatalogClass_[K, V]) ImmutableCatalog() ImmutableCatalogLike[K, V] {
+	var instance = &immutableCatalog_[K, V]{
+		// Initialize the instance attributes.
+		root_: &hamtNode_[K, V]{},
+	}
+	return instance
+}
+
+func (c *immutableCatalogClass_[K, V]) ImmutableCatalogFromMap(
+	associations map[K]V,
+) ImmutableCatalogLike[K, V] {
+	// The catalog class makes the ordering of the associations deterministic.
+	var catalog = CatalogClass[K, V]().CatalogFromMap(associations)
+	return c.ImmutableCatalogFromSequence(catalog)
+}
+
+func (c *immutableCatalogClass_[K, V]) ImmutableCatalogFromSequence(
+	associations Sequential[AssociationLike[K, V]],
+) ImmutableCatalogLike[K, V] {
+	var catalog = c.ImmutableCatalog()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key = association.GetKey()
+		var value = association.GetValue()
+		catalog = catalog.WithValue(key, value)
+	}
+	return catalog
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *immutableCatalog_[K, V]) GetClass() ImmutableCatalogClassLike[K, V] {
+	return immutableCatalogClass[K, V]()
+}
+
+func (v *immutableCatalog_[K, V]) AsCatalog() CatalogLike[K, V] {
+	var catalog = CatalogClass[K, V]().CatalogFromSequence(v)
+	return catalog
+}
+
+func (v *immutableCatalog_[K, V]) AsMap() map[K]V {
+	var map_ = map[K]V{}
+	for _, entry := range v.order_.appendValues(nil) {
+		map_[entry.key_] = entry.value_
+	}
+	return map_
+}
+
+func (v *immutableCatalog_[K, V]) GetValue(
+	key K,
+) V {
+	var value V // Set the return value to its zero value.
+	var class = immutableCatalogClass[K, V]()
+	var entry = v.root_.findEntry(class.hashOf(key), key, 0)
+	if entry != nil {
+		// Extract the value.
+		value = entry.value_
+	}
+	return value
+}
+
+func (v *immutableCatalog_[K, V]) GetKeys() Sequential[K] {
+	var entries = v.order_.appendValues(nil)
+	var keys = make([]K, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.key_)
+	}
+	return ImmutableListClass[K]().ImmutableListFromArray(keys)
+}
+
+func (v *immutableCatalog_[K, V]) GetValues(
+	keys Sequential[K],
+) Sequential[V] {
+	var values []V
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		values = append(values, v.GetValue(key))
+	}
+	return ImmutableListClass[V]().ImmutableListFromArray(values)
+}
+
+func (v *immutableCatalog_[K, V]) WithValue(
+	key K,
+	value V,
+) ImmutableCatalogLike[K, V] {
+	var class = immutableCatalogClass[K, V]()
+	var hash = class.hashOf(key)
+	var result = *v
+	var entry = &hamtEntry_[K, V]{
+		hash_:     hash,
+		key_:      key,
+		value_:    value,
+		sequence_: v.sequence_,
+	}
+	var existing = v.root_.findEntry(hash, key, 0)
+	if existing != nil {
+		// An existing key retains its position in the catalog.
+		entry.sequence_ = existing.sequence_
+	} else {
+		result.size_++
+		result.sequence_++
+	}
+	result.root_ = v.root_.withEntry(entry, 0)
+	result.order_ = v.order_.replacingValue(entry, class.ranker_)
+	return &result
+}
+
+func (v *immutableCatalog_[K, V]) WithoutValue(
+	key K,
+) ImmutableCatalogLike[K, V] {
+	var class = immutableCatalogClass[K, V]()
+	var hash = class.hashOf(key)
+	var existing = v.root_.findEntry(hash, key, 0)
+	if existing == nil {
+		// The key is not in this catalog so it may be shared.
+		return v
+	}
+	var result = *v
+	result.size_--
+	result.root_ = v.root_.withoutEntry(hash, key, 0)
+	result.order_, _ = v.order_.withoutValue(existing, class.ranker_)
+	return &result
+}
+
+// Attribute Methods
+
+// Sequential[AssociationLike[K, V]] Methods
+
+func (v *immutableCatalog_[K, V]) IsEmpty() bool {
+	return v.size_ == 0
+}
+
+func (v *immutableCatalog_[K, V]) GetSize() uint {
+	return uint(v.size_)
+}
+
+func (v *immutableCatalog_[K, V]) AsArray() []AssociationLike[K, V] {
+	var entries = v.order_.appendValues(nil)
+	var array = make([]AssociationLike[K, V], 0, len(entries))
+	for _, entry := range entries {
+		array = append(array, entry.asAssociation())
+	}
+	return array
+}
+
+func (v *immutableCatalog_[K, V]) GetIterator() uti.IteratorLike[AssociationLike[K, V]] {
+	// Each association is created as it is retrieved since associations are
+	// mutable.
+	var iterator = &indexedIterator_[AssociationLike[K, V]]{
+		size_: v.GetSize(),
+		valueAt_: func(slot int) AssociationLike[K, V] {
+			return v.order_.valueAt(slot).asAssociation()
+		},
+	}
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *immutableCatalog_[K, V]) String() string {
+	return uti.Format(v)
+}
+
+// Private Methods
+
+func (c *immutableCatalogClass_[K, V]) hashOf(
+	key K,
+) uint64 {
+	return has.Comparable(c.seed_, key)
+}
+
+// Instance Structure
+
+type immutableCatalog_[K comparable, V any] struct {
+	// Declare the instance attributes.
+	size_     int
+	sequence_ uint64
+	root_     *hamtNode_[K, V]
+	order_    *treeNode_[*hamtEntry_[K, V]]
+}
+
+// Class Structure
+
+type immutableCatalogClass_[K comparable, V any] struct {
+	// Declare the class constants.
+	seed_   has.Seed
+	ranker_ age.RankingFunction[*hamtEntry_[K, V]]
+}
+
+// Class Reference
+
+var immutableCatalogMap_ = map[string]any{}
+var immutableCatalogMutex_ syn.Mutex
+
+func immutableCatalogClass[K comparable, V any]() *immutableCatalogClass_[K, V] {
+	// Generate the name of the bound class type.
+	var class *immutableCatalogClass_[K, V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	immutableCatalogMutex_.Lock()
+	var value = immutableCatalogMap_[name]
+	switch actual := value.(type) {
+	case *immutableCatalogClass_[K, V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &immutableCatalogClass_[K, V]{
+			// Initialize the class constants.
+			seed_: has.MakeSeed(),
+			ranker_: func(first, second *hamtEntry_[K, V]) age.Rank {
+				switch {
+				case first.sequence_ < second.sequence_:
+					return age.LesserRank
+				case first.sequence_ > second.sequence_:
+					return age.GreaterRank
+				default:
+					return age.EqualRank
+				}
+			},
+		}
+		immutableCatalogMap_[name] = class
+	}
+	immutableCatalogMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
+
+/*
+NOTE:
+The following is a private implementation of a persistent hash array mapped
+trie (HAMT).  Each node uses five bits of the hash of a key to select one of up
+to 32 branches, and only the branches that are present are stored.  Keys whose
+hashes are identical end up in a single node containing a list of collisions.
+Nodes are never changed once they are part of a trie.  Each entry also records
+the sequence number that determines its position in the catalog, and the
+entries are kept in that order by a separate persistent tree.
+*/
+
+type hamtEntry_[K comparable, V any] struct {
+	hash_     uint64
+	key_      K
+	value_    V
+	sequence_ uint64
+}
+
+func (v *hamtEntry_[K, V]) asAssociation() AssociationLike[K, V] {
+	return AssociationClass[K, V]().Association(v.key_, v.value_)
+}
+
+type hamtBranch_[K comparable, V any] struct {
+	entry_ *hamtEntry_[K, V]
+	node_  *hamtNode_[K, V]
+}
+
+type hamtNode_[K comparable, V any] struct {
+	bitmap_     uint32
+	branches_   []hamtBranch_[K, V]
+	collisions_ []*hamtEntry_[K, V]
+}
+
+// This private function returns the bit in the bitmap of a node at the
+// specified depth that corresponds to the specified hash.
+func hamtBit(
+	hash uint64,
+	shift uint,
+) uint32 {
+	return 1 << ((hash >> shift) & 31)
+}
+
+// This private method returns the position of the branch for the specified bit
+// within the branches of the node.
+func (v *hamtNode_[K, V]) position(
+	bit_ uint32,
+) int {
+	return bit.OnesCount32(v.bitmap_ & (bit_ - 1))
+}
+
+func (v *hamtNode_[K, V]) findEntry(
+	hash uint64,
+	key K,
+	shift uint,
+) *hamtEntry_[K, V] {
+	var node = v
+	for ; shift < 64; shift += 5 {
+		var bit_ = hamtBit(hash, shift)
+		if node.bitmap_&bit_ == 0 {
+			return nil
+		}
+		var branch = node.branches_[node.position(bit_)]
+		if branch.entry_ != nil {
+			if branch.entry_.key_ == key {
+				return branch.entry_
+			}
+			return nil
+		}
+		node = branch.node_
+	}
+	for _, entry := range node.collisions_ {
+		if entry.key_ == key {
+			return entry
+		}
+	}
+	return nil
+}
+
+// This private method returns a copy of the node containing the specified
+// entry in place of any existing entry with the same key.
+func (v *hamtNode_[K, V]) withEntry(
+	entry *hamtEntry_[K, V],
+	shift uint,
+) *hamtNode_[K, V] {
+	var result = &hamtNode_[K, V]{bitmap_: v.bitmap_}
+	if shift >= 64 {
+		// All hash bits have been used so the keys collide.
+		result.collisions_ = []*hamtEntry_[K, V]{entry}
+		for _, collision := range v.collisions_ {
+			if collision.key_ != entry.key_ {
+				result.collisions_ = append(result.collisions_, collision)
+			}
+		}
+		return result
+	}
+	var bit_ = hamtBit(entry.hash_, shift)
+	var position = v.position(bit_)
+	if v.bitmap_&bit_ == 0 {
+		// Insert a new branch for the entry.
+		result.bitmap_ |= bit_
+		result.branches_ = make([]hamtBranch_[K, V], 0, len(v.branches_)+1)
+		result.branches_ = append(result.branches_, v.branches_[:position]...)
+		result.branches_ = append(result.branches_, hamtBranch_[K, V]{entry_: entry})
+		result.branches_ = append(result.branches_, v.branches_[position:]...)
+		return result
+	}
+	result.branches_ = uti.CopyArray(v.branches_)
+	var branch = v.branches_[position]
+	switch {
+	case branch.node_ != nil:
+		// Add the entry to the subtrie.
+		branch.node_ = branch.node_.withEntry(entry, shift+5)
+	case branch.entry_.key_ == entry.key_:
+		// Replace the existing entry.
+		branch.entry_ = entry
+	default:
+		// Push both entries down into a new subtrie.
+		var node = &hamtNode_[K, V]{}
+		node = node.withEntry(branch.entry_, shift+5)
+		node = node.withEntry(entry, shift+5)
+		branch = hamtBranch_[K, V]{node_: node}
+	}
+	result.branches_[position] = branch
+	return result
+}
+
+// This private method returns a copy of the node without the entry having the
+// specified key, which must be in the trie.
+func (v *hamtNode_[K, V]) withoutEntry(
+	hash uint64,
+	key K,
+	shift uint,
+) *hamtNode_[K, V] {
+	var result = &hamtNode_[K, V]{bitmap_: v.bitmap_}
+	if shift >= 64 {
+		for _, collision := range v.collisions_ {
+			if collision.key_ != key {
+				result.collisions_ = append(result.collisions_, collision)
+			}
+		}
+		return result
+	}
+	var bit_ = hamtBit(hash, shift)
+	var position = v.position(bit_)
+	var branch = v.branches_[position]
+	if branch.node_ != nil {
+		var node = branch.node_.withoutEntry(hash, key, shift+5)
+		var single = node.singleEntry()
+		if single == nil {
+			branch.node_ = node
+		} else {
+			// Collapse the subtrie into its only remaining entry.
+			branch = hamtBranch_[K, V]{entry_: single}
+		}
+		result.branches_ = uti.CopyArray(v.branches_)
+		result.branches_[position] = branch
+		return result
+	}
+
+	// Remove the branch for the entry.
+	result.bitmap_ &^= bit_
+	result.branches_ = make([]hamtBranch_[K, V], 0, len(v.branches_)-1)
+	result.branches_ = append(result.branches_, v.branches_[:position]...)
+	result.branches_ = append(result.branches_, v.branches_[position+1:]...)
+	return result
+}
+
+// This private method returns the only entry in the node if it contains exactly
+// one entry and no subtries, otherwise it returns nil.
+func (v *hamtNode_[K, V]) singleEntry() *hamtEntry_[K, V] {
+	switch {
+	case len(v.collisions_) == 1:
+		return v.collisions_[0]
+	case len(v.branches_) == 1 && v.branches_[0].entry_ != nil:
+		return v.branches_[0].entry_
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,730 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ImmutableListClass[V any]() ImmutableListClassLike[V] {
+	return immutableListClass[V]()
+}
+
+// Constructor Methods
+
+func (c *immutableListClass_[V]) ImmutableList() ImmutableListLike[V] {
+	var collator = age.CollatorClass[V]().Collator()
+	var instance = c.ImmutableListWithCollator(collator)
+	return instance
+}
+
+func (c *immutableListClass_[V]) ImmutableListWithCollator(
+	collator age.CollatorLike[V],
+) ImmutableListLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = c.buildList(nil, collator)
+	return instance
+}
+
+func (c *immutableListClass_[V]) ImmutableListFromArray(
+	values []V,
+) ImmutableListLike[V] {
+	var collator = age.CollatorClass[V]().Collator()
+	var instance = c.buildList(values, collator)
+	return instance
+}
+
+func (c *immutableListClass_[V]) ImmutableListFromSequence(
+	values Sequential[V],
+) ImmutableListLike[V] {
+	// Use the collator of the sequence if it has one.
+	var collator age.CollatorLike[V]
+	if collated, ok := values.(interface{ GetCollator() age.CollatorLike[V] }); ok {
+		collator = collated.GetCollator()
+	} else {
+		collator = age.CollatorClass[V]().Collator()
+	}
+	var instance = c.buildList(values.AsArray(), collator)
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *immutableList_[V]) GetClass() ImmutableListClassLike[V] {
+	return immutableListClass[V]()
+}
+
+func (v *immutableList_[V]) AsList() ListLike[V] {
+	var list = &list_[V]{
+		// Initialize the instance attributes.
+		collator_: v.collator_,
+		array_:    v.AsArray(),
+	}
+	return list
+}
+
+func (v *immutableList_[V]) WithValue(
+	index int,
+	value V,
+) ImmutableListLike[V] {
+	var slot = int(uti.RelativeToCardinal(index, v.GetSize()))
+	var result = *v
+	if slot >= v.tailOffset() {
+		// The value is in the tail.
+		result.tail_ = uti.CopyArray(v.tail_)
+		result.tail_[slot-v.tailOffset()] = value
+	} else {
+		// The value is in the trie.
+		result.root_ = v.replaceValue(v.root_, v.shift_, slot, value)
+	}
+	return &result
+}
+
+func (v *immutableList_[V]) WithAppendedValue(
+	value V,
+) ImmutableListLike[V] {
+	var class = immutableListClass[V]()
+	var result = *v
+	result.size_++
+	if len(v.tail_) < class.width_ {
+		// There is still room in the tail.
+		result.tail_ = append(uti.CopyArray(v.tail_), value)
+		return &result
+	}
+
+	// Push the full tail into the trie and start a new one.
+	var leaf = &trieNode_[V]{values_: v.tail_}
+	if v.size_>>class.bits_ > 1<<v.shift_ {
+		// The root is full so the trie must grow a level.
+		result.root_ = &trieNode_[V]{
+			branches_: []*trieNode_[V]{v.root_, v.newPath(v.shift_, leaf)},
+		}
+		result.shift_ += class.bits_
+	} else {
+		result.root_ = v.pushLeaf(v.root_, v.shift_, leaf)
+	}
+	result.tail_ = []V{value}
+	return &result
+}
+
+func (v *immutableList_[V]) WithAppendedValues(
+	values Sequential[V],
+) ImmutableListLike[V] {
+	var result ImmutableListLike[V] = v
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		result = result.WithAppendedValue(value)
+	}
+	return result
+}
+
+func (v *immutableList_[V]) WithInsertedValue(
+	slot uint,
+	value V,
+) ImmutableListLike[V] {
+	if slot > v.GetSize() {
+		var message = fmt.Sprintf(
+			"The slot %v is beyond the end of an immutable list of size %v.",
+			slot,
+			v.size_,
+		)
+		panic(message)
+	}
+	var values = append([]V{value}, v.valuesFrom(int(slot))...)
+	return v.withPrefix(int(slot)).withAppendedArray(values)
+}
+
+func (v *immutableList_[V]) WithoutValue(
+	index int,
+) ImmutableListLike[V] {
+	var slot = int(uti.RelativeToCardinal(index, v.GetSize()))
+	var values = v.valuesFrom(slot + 1)
+	return v.withPrefix(slot).withAppendedArray(values)
+}
+
+func (v *immutableList_[V]) WithoutLastValue() ImmutableListLike[V] {
+	var class = immutableListClass[V]()
+	switch {
+	case v.size_ == 0:
+		panic("Cannot remove the last value from an empty immutable list.")
+	case v.size_ == 1:
+		return v.withPrefix(0)
+	}
+	var result = *v
+	result.size_--
+	if len(v.tail_) > 1 {
+		// The tail will still contain a value.
+		result.tail_ = uti.CopyArray(v.tail_[:len(v.tail_)-1])
+		return &result
+	}
+
+	// Pop the last leaf out of the trie to become the new tail.
+	result.tail_ = v.leafFor(v.size_ - 2).values_
+	result.root_ = v.popLeaf(v.root_, v.shift_)
+	if result.root_ == nil {
+		result.root_ = &trieNode_[V]{}
+	}
+	if result.shift_ > class.bits_ && len(result.root_.branches_) == 1 {
+		// The trie can shrink a level.
+		result.root_ = result.root_.branches_[0]
+		result.shift_ -= class.bits_
+	}
+	return &result
+}
+
+// Attribute Methods
+
+func (v *immutableList_[V]) GetCollator() age.CollatorLike[V] {
+	return v.collator_
+}
+
+// Accessible[V] Methods
+
+func (v *immutableList_[V]) GetValue(
+	index int,
+) V {
+	var slot = int(uti.RelativeToCardinal(index, v.GetSize()))
+	return v.valueAt(slot)
+}
+
+func (v *immutableList_[V]) GetValues(
+	first int,
+	last int,
+) Sequential[V] {
+	var size = v.GetSize()
+	var goFirst = int(uti.RelativeToCardinal(first, size))
+	var goLast = int(uti.RelativeToCardinal(last, size))
+	var values = make([]V, 0, goLast-goFirst+1)
+	for slot := goFirst; slot <= goLast; slot++ {
+		values = append(values, v.valueAt(slot))
+	}
+	return v.newList(values)
+}
+
+func (v *immutableList_[V]) GetIndex(
+	value V,
+) int {
+	for slot := 0; slot < v.size_; slot++ {
+		if v.collator_.CompareValues(v.valueAt(slot), value) {
+			// Found the value.
+			return slot + 1
+		}
+	}
+	// The value was not found.
+	return 0
+}
+
+// Searchable[V] Methods
+
+func (v *immutableList_[V]) ContainsValue(
+	value V,
+) bool {
+	return v.GetIndex(value) > 0
+}
+
+func (v *immutableList_[V]) ContainsAny(
+	values Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var candidate = iterator.GetNext()
+		if v.GetIndex(candidate) > 0 {
+			// Found one of the values.
+			return true
+		}
+	}
+	// Did not find any of the values.
+	return false
+}
+
+func (v *immutableList_[V]) ContainsAll(
+	values Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var candidate = iterator.GetNext()
+		if v.GetIndex(candidate) == 0 {
+			// One of the values is missing.
+			return false
+		}
+	}
+	// Found all of the values.
+	return true
+}
+
+// Sequential[V] Methods
+
+func (v *immutableList_[V]) IsEmpty() bool {
+	return v.size_ == 0
+}
+
+func (v *immutableList_[V]) GetSize() uint {
+	return uint(v.size_)
+}
+
+func (v *immutableList_[V]) AsArray() []V {
+	var class = immutableListClass[V]()
+	var array = make([]V, 0, v.size_)
+	for offset := 0; offset < v.tailOffset(); offset += class.width_ {
+		array = append(array, v.leafFor(offset).values_...)
+	}
+	array = append(array, v.tail_...)
+	return array
+}
+
+func (v *immutableList_[V]) GetIterator() uti.IteratorLike[V] {
+	// Since the list never changes its values need not be copied.
+	var iterator = &indexedIterator_[V]{
+		size_:    v.GetSize(),
+		valueAt_: v.valueAt,
+	}
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *immutableList_[V]) String() string {
+	return uti.Format(v)
+}
+
+// Private Methods
+
+// This private instance method returns a new immutable list containing the
+// specified values that uses the same collator as this list.
+func (v *immutableList_[V]) newList(
+	values []V,
+) ImmutableListLike[V] {
+	return immutableListClass[V]().buildList(values, v.collator_)
+}
+
+// This private instance method returns a new immutable list containing the
+// values in this list followed by the specified values.
+func (v *immutableList_[V]) withAppendedArray(
+	values []V,
+) ImmutableListLike[V] {
+	var result ImmutableListLike[V] = v
+	for _, value := range values {
+		result = result.WithAppendedValue(value)
+	}
+	return result
+}
+
+// This private instance method returns a new immutable list containing the
+// values before the specified slot.  Only the path to the last of those values
+// is copied so the new list takes O[log32(n)] time to create and shares the
+// rest of its values with this list.
+func (v *immutableList_[V]) withPrefix(
+	slot int,
+) *immutableList_[V] {
+	var class = immutableListClass[V]()
+	var result = &immutableList_[V]{
+		// Initialize the instance attributes.
+		collator_: v.collator_,
+		size_:     slot,
+		shift_:    class.bits_,
+		root_:     &trieNode_[V]{},
+	}
+	if slot == 0 {
+		return result
+	}
+	var offset = class.tailOffset(slot)
+	if offset == v.tailOffset() {
+		// The trie is unchanged and the new tail is the start of the old tail.
+		result.shift_ = v.shift_
+		result.root_ = v.root_
+		result.tail_ = uti.CopyArray(v.tail_[:slot-offset])
+		return result
+	}
+
+	// The leaf containing the last value becomes the new tail.
+	result.tail_ = uti.CopyArray(v.leafFor(offset).values_[:slot-offset])
+	var leaves = offset >> class.bits_
+	if leaves > 0 {
+		result.shift_ = v.shift_
+		result.root_ = v.takeLeaves(v.root_, v.shift_, leaves)
+		for result.shift_ > class.bits_ && len(result.root_.branches_) == 1 {
+			// The trie can shrink a level.
+			result.root_ = result.root_.branches_[0]
+			result.shift_ -= class.bits_
+		}
+	}
+	return result
+}
+
+// This private instance method returns a copy of the specified node that
+// contains only its specified number of leading leaves.
+func (v *immutableList_[V]) takeLeaves(
+	node *trieNode_[V],
+	shift uint,
+	leaves int,
+) *trieNode_[V] {
+	var class = immutableListClass[V]()
+	if shift == class.bits_ {
+		var result = &trieNode_[V]{
+			branches_: uti.CopyArray(node.branches_[:leaves]),
+		}
+		return result
+	}
+	var perBranch = 1 << (shift - class.bits_)
+	var last = (leaves - 1) / perBranch
+	var result = &trieNode_[V]{
+		branches_: uti.CopyArray(node.branches_[:last+1]),
+	}
+	result.branches_[last] = v.takeLeaves(
+		node.branches_[last],
+		shift-class.bits_,
+		leaves-last*perBranch,
+	)
+	return result
+}
+
+// This private instance method returns a copy of the specified node in which
+// the value at the specified slot has been replaced.
+func (v *immutableList_[V]) replaceValue(
+	node *trieNode_[V],
+	shift uint,
+	slot int,
+	value V,
+) *trieNode_[V] {
+	var class = immutableListClass[V]()
+	var result = &trieNode_[V]{}
+	if shift == 0 {
+		result.values_ = uti.CopyArray(node.values_)
+		result.values_[slot&class.mask_] = value
+		return result
+	}
+	var index = (slot >> shift) & class.mask_
+	result.branches_ = uti.CopyArray(node.branches_)
+	result.branches_[index] = v.replaceValue(node.branches_[index], shift-class.bits_, slot, value)
+	return result
+}
+
+// This private instance method returns a copy of the specified node with the
+// specified leaf pushed onto the end of the trie.
+func (v *immutableList_[V]) pushLeaf(
+	node *trieNode_[V],
+	shift uint,
+	leaf *trieNode_[V],
+) *trieNode_[V] {
+	var class = immutableListClass[V]()
+	var index = ((v.size_ - 1) >> shift) & class.mask_
+	var result = &trieNode_[V]{
+		branches_: uti.CopyArray(node.branches_),
+	}
+	var child = leaf
+	if shift > class.bits_ {
+		if index < len(node.branches_) {
+			child = v.pushLeaf(node.branches_[index], shift-class.bits_, leaf)
+		} else {
+			child = v.newPath(shift-class.bits_, leaf)
+		}
+	}
+	if index < len(result.branches_) {
+		result.branches_[index] = child
+	} else {
+		result.branches_ = append(result.branches_, child)
+	}
+	return result
+}
+
+// This private instance method returns a copy of the specified node with its
+// last leaf removed, or nil if the node would be empty.
+func (v *immutableList_[V]) popLeaf(
+	node *trieNode_[V],
+	shift uint,
+) *trieNode_[V] {
+	var class = immutableListClass[V]()
+	var index = ((v.size_ - 2) >> shift) & class.mask_
+	if shift > class.bits_ {
+		var child = v.popLeaf(node.branches_[index], shift-class.bits_)
+		if child == nil && index == 0 {
+			return nil
+		}
+		var result = &trieNode_[V]{
+			branches_: uti.CopyArray(node.branches_[:index+1]),
+		}
+		if child == nil {
+			result.branches_ = result.branches_[:index]
+		} else {
+			result.branches_[index] = child
+		}
+		return result
+	}
+	if index == 0 {
+		return nil
+	}
+	var result = &trieNode_[V]{
+		branches_: uti.CopyArray(node.branches_[:index]),
+	}
+	return result
+}
+
+// This private instance method returns a new path of single branch nodes from
+// the specified level down to the specified node.
+func (v *immutableList_[V]) newPath(
+	shift uint,
+	node *trieNode_[V],
+) *trieNode_[V] {
+	if shift == 0 {
+		return node
+	}
+	var class = immutableListClass[V]()
+	var path = &trieNode_[V]{
+		branches_: []*trieNode_[V]{v.newPath(shift-class.bits_, node)},
+	}
+	return path
+}
+
+// This private instance method returns the leaf node containing the value at
+// the specified slot (which must not be in the tail).
+func (v *immutableList_[V]) leafFor(
+	slot int,
+) *trieNode_[V] {
+	var class = immutableListClass[V]()
+	var node = v.root_
+	for shift := v.shift_; shift > 0; shift -= class.bits_ {
+		node = node.branches_[(slot>>shift)&class.mask_]
+	}
+	return node
+}
+
+func (v *immutableList_[V]) tailOffset() int {
+	return immutableListClass[V]().tailOffset(v.size_)
+}
+
+// This private instance method returns the values from the specified slot to
+// the end of this list.
+func (v *immutableList_[V]) valuesFrom(
+	slot int,
+) []V {
+	var values = make([]V, 0, v.size_-slot)
+	for ; slot < v.size_; slot++ {
+		values = append(values, v.valueAt(slot))
+	}
+	return values
+}
+
+func (v *immutableList_[V]) valueAt(
+	slot int,
+) V {
+	var offset = v.tailOffset()
+	if slot >= offset {
+		return v.tail_[slot-offset]
+	}
+	var class = immutableListClass[V]()
+	return v.leafFor(slot).values_[slot&class.mask_]
+}
+
+// This private class method returns a new immutable list containing the
+// specified values that uses the specified collator.
+func (c *immutableListClass_[V]) buildList(
+	values []V,
+	collator age.CollatorLike[V],
+) ImmutableListLike[V] {
+	// Split the values into full leaves and a partial tail.
+	var size = len(values)
+	var offset = c.tailOffset(size)
+	var tail = uti.CopyArray(values[offset:])
+	var nodes []*trieNode_[V]
+	for first := 0; first < offset; first += c.width_ {
+		var leaf = &trieNode_[V]{
+			values_: uti.CopyArray(values[first : first+c.width_]),
+		}
+		nodes = append(nodes, leaf)
+	}
+
+	// Build the trie from its leaves up to its root.
+	var shift = c.bits_
+	for len(nodes) > c.width_ {
+		var parents []*trieNode_[V]
+		for first := 0; first < len(nodes); first += c.width_ {
+			var last = min(first+c.width_, len(nodes))
+			var parent = &trieNode_[V]{
+				branches_: nodes[first:last:last],
+			}
+			parents = append(parents, parent)
+		}
+		nodes = parents
+		shift += c.bits_
+	}
+	var instance = &immutableList_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+		size_:     size,
+		shift_:    shift,
+		root_:     &trieNode_[V]{branches_: nodes},
+		tail_:     tail,
+	}
+	return instance
+}
+
+// This private class method returns the slot of the first value in the tail
+// of an immutable list of the specified size.
+func (c *immutableListClass_[V]) tailOffset(
+	size int,
+) int {
+	if size < c.width_ {
+		return 0
+	}
+	return ((size - 1) >> c.bits_) << c.bits_
+}
+
+// Instance Structure
+
+type immutableList_[V any] struct {
+	// Declare the instance attributes.
+	collator_ age.CollatorLike[V]
+	size_     int
+	shift_    uint
+	root_     *trieNode_[V]
+	tail_     []V
+}
+
+// Class Structure
+
+type immutableListClass_[V any] struct {
+	// Declare the class constants.
+	bits_  uint
+	width_ int
+	mask_  int
+}
+
+// Class Reference
+
+var immutableListMap_ = map[string]any{}
+var immutableListMutex_ syn.Mutex
+
+func immutableListClass[V any]() *immutableListClass_[V] {
+	// Generate the name of the bound class type.
+	var class *immutableListClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	immutableListMutex_.Lock()
+	var value = immutableListMap_[name]
+	switch actual := value.(type) {
+	case *immutableListClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &immutableListClass_[V]{
+			// Initialize the class constants.
+			bits_:  5,
+			width_: 32,
+			mask_:  31,
+		}
+		immutableListMap_[name] = class
+	}
+	immutableListMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
+
+/*
+NOTE:
+The following is a private implementation of the nodes in the trie that holds
+the values of an immutable list.  A leaf node holds up to 32 values and every
+other node holds up to 32 branches.  Nodes are never changed once they are part
+of an immutable list, so they may be shared by many immutable lists.
+*/
+
+type trieNode_[V any] struct {
+	branches_ []*trieNode_[V]
+	values_   []V
+}
+
+/*
+NOTE:
+The following is a private implementation of an Iterator class that retrieves
+each value by its slot rather than requiring a copy of the values.  This is
+safe for the immutable collections since their values never change.
+*/
+
+type indexedIterator_[V any] struct {
+	slot_    uint
+	size_    uint
+	valueAt_ func(slot int) V
+}
+
+func (v *indexedIterator_[V]) IsEmpty() bool {
+	return v.size_ == 0
+}
+
+func (v *indexedIterator_[V]) ToStart() {
+	v.slot_ = 0
+}
+
+func (v *indexedIterator_[V]) ToEnd() {
+	v.slot_ = v.size_
+}
+
+func (v *indexedIterator_[V]) HasPrevious() bool {
+	return v.slot_ > 0
+}
+
+func (v *indexedIterator_[V]) GetPrevious() V {
+	var result_ V
+	if v.slot_ > 0 {
+		v.slot_--
+		result_ = v.valueAt_(int(v.slot_))
+	}
+	return result_
+}
+
+func (v *indexedIterator_[V]) HasNext() bool {
+	return v.slot_ < v.size_
+}
+
+func (v *indexedIterator_[V]) GetNext() V {
+	var result_ V
+	if v.slot_ < v.size_ {
+		result_ = v.valueAt_(int(v.slot_))
+		v.slot_++
+	}
+	return result_
+}
+
+func (v *indexedIterator_[V]) GetSize() uint {
+	return v.size_
+}
+
+func (v *indexedIterator_[V]) GetSlot() uint {
+	return v.slot_
+}
+
+func (v *indexedIterator_[V]) SetSlot(
+	slot uint,
+) {
+	if slot > v.size_ {
+		slot = v.size_
+	}
+	v.slot_ = slot
+}
@@ -0,0 +1,599 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ImmutableSetClass[V any]() ImmutableSetClassLike[V] {
+	return immutableSetClass[V]()
+}
+
+// Constructor Methods
+
+func (c *immutableSetClass_[V]) ImmutableSet() ImmutableSetLike[V] {
+	var collator = age.CollatorClass[V]().Collator()
+	var instance = c.ImmutableSetWithCollator(collator)
+	return instance
+}
+
+func (c *immutableSetClass_[V]) ImmutableSetWithCollator(
+	collator age.CollatorLike[V],
+) ImmutableSetLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &immutableSet_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+	}
+	return instance
+}
+
+func (c *immutableSetClass_[V]) ImmutableSetFromArray(
+	values []V,
+) ImmutableSetLike[V] {
+	var collator = age.CollatorClass[V]().Collator()
+	var instance = c.buildSet(values, collator)
+	return instance
+}
+
+func (c *immutableSetClass_[V]) ImmutableSetFromSequence(
+	values Sequential[V],
+) ImmutableSetLike[V] {
+	// Use the collator of the sequence if it has one.
+	var collator age.CollatorLike[V]
+	if collated, ok := values.(interface{ GetCollator() age.CollatorLike[V] }); ok {
+		collator = collated.GetCollator()
+	} else {
+		collator = age.CollatorClass[V]().Collator()
+	}
+	var instance = c.buildSet(values.AsArray(), collator)
+	return instance
+}
+
+func (c *immutableSetClass_[V]) ImmutableSetFromSequenceWithCollator(
+	values Sequential[V],
+	collator age.CollatorLike[V],
+) ImmutableSetLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = c.buildSet(values.AsArray(), collator)
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *immutableSet_[V]) GetClass() ImmutableSetClassLike[V] {
+	return immutableSetClass[V]()
+}
+
+func (v *immutableSet_[V]) AsSet() SetLike[V] {
+	var set = SetClass[V]().SetWithCollator(v.collator_)
+	set.AddValues(v)
+	return set
+}
+
+func (v *immutableSet_[V]) WithValue(
+	value V,
+) ImmutableSetLike[V] {
+	var root, changed = v.root_.withValue(value, v.collator_.RankValues)
+	if !changed {
+		// The value is already a member so this set may be shared.
+		return v
+	}
+	var result = *v
+	result.root_ = root
+	return &result
+}
+
+func (v *immutableSet_[V]) WithValues(
+	values Sequential[V],
+) ImmutableSetLike[V] {
+	var result ImmutableSetLike[V] = v
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		result = result.WithValue(value)
+	}
+	return result
+}
+
+func (v *immutableSet_[V]) WithoutValue(
+	value V,
+) ImmutableSetLike[V] {
+	var root, changed = v.root_.withoutValue(value, v.collator_.RankValues)
+	if !changed {
+		// The value is not a member so this set may be shared.
+		return v
+	}
+	var result = *v
+	result.root_ = root
+	return &result
+}
+
+func (v *immutableSet_[V]) WithoutValues(
+	values Sequential[V],
+) ImmutableSetLike[V] {
+	var result ImmutableSetLike[V] = v
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		result = result.WithoutValue(value)
+	}
+	return result
+}
+
+// Attribute Methods
+
+func (v *immutableSet_[V]) GetCollator() age.CollatorLike[V] {
+	return v.collator_
+}
+
+// Accessible[V] Methods
+
+func (v *immutableSet_[V]) GetValue(
+	index int,
+) V {
+	var slot = uti.RelativeToCardinal(index, v.GetSize())
+	return v.root_.valueAt(int(slot))
+}
+
+func (v *immutableSet_[V]) GetValues(
+	first int,
+	last int,
+) Sequential[V] {
+	var size = v.GetSize()
+	var goFirst = int(uti.RelativeToCardinal(first, size))
+	var goLast = int(uti.RelativeToCardinal(last, size))
+	var values = make([]V, 0, goLast-goFirst+1)
+	for slot := goFirst; slot <= goLast; slot++ {
+		values = append(values, v.root_.valueAt(slot))
+	}
+	var result = &immutableSet_[V]{
+		collator_: v.collator_,
+		root_:     buildTree(values),
+	}
+	return result
+}
+
+func (v *immutableSet_[V]) GetIndex(
+	value V,
+) int {
+	return v.root_.indexOf(value, v.collator_.RankValues)
+}
+
+// Searchable[V] Methods
+
+func (v *immutableSet_[V]) ContainsValue(
+	value V,
+) bool {
+	return v.GetIndex(value) > 0
+}
+
+func (v *immutableSet_[V]) ContainsAny(
+	values Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if v.ContainsValue(value) {
+			// This set contains at least one of the values.
+			return true
+		}
+	}
+	// This set does not contain any of the values.
+	return false
+}
+
+func (v *immutableSet_[V]) ContainsAll(
+	values Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if !v.ContainsValue(value) {
+			// This set is missing at least one of the values.
+			return false
+		}
+	}
+	// This set does contains all of the values.
+	return true
+}
+
+// Sequential[V] Methods
+
+func (v *immutableSet_[V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *immutableSet_[V]) GetSize() uint {
+	return uint(v.root_.getSize())
+}
+
+func (v *immutableSet_[V]) AsArray() []V {
+	var array = make([]V, 0, v.root_.getSize())
+	return v.root_.appendValues(array)
+}
+
+func (v *immutableSet_[V]) GetIterator() uti.IteratorLike[V] {
+	// Since the set never changes its values need not be copied.
+	var iterator = &indexedIterator_[V]{
+		size_:    v.GetSize(),
+		valueAt_: v.root_.valueAt,
+	}
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *immutableSet_[V]) String() string {
+	return uti.Format(v)
+}
+
+// Private Methods
+
+// This private class method builds a balanced tree directly from a sorted copy
+// of the specified values with any duplicates removed.
+func (c *immutableSetClass_[V]) buildSet(
+	values []V,
+	collator age.CollatorLike[V],
+) ImmutableSetLike[V] {
+	var ranker = collator.RankValues
+	var sorted = uti.CopyArray(values)
+	age.SorterClass[V]().SorterWithRanker(ranker).SortValues(sorted)
+	var unique = sorted[:0]
+	for _, value := range sorted {
+		var last = len(unique) - 1
+		if last < 0 || ranker(unique[last], value) != age.EqualRank {
+			unique = append(unique, value)
+		}
+	}
+	var instance = &immutableSet_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+		root_:     buildTree(unique),
+	}
+	return instance
+}
+
+// Instance Structure
+
+type immutableSet_[V any] struct {
+	// Declare the instance attributes.
+	collator_ age.CollatorLike[V]
+	root_     *treeNode_[V]
+}
+
+// Class Structure
+
+type immutableSetClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var immutableSetMap_ = map[string]any{}
+var immutableSetMutex_ syn.Mutex
+
+func immutableSetClass[V any]() *immutableSetClass_[V] {
+	// Generate the name of the bound class type.
+	var class *immutableSetClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	immutableSetMutex_.Lock()
+	var value = immutableSetMap_[name]
+	switch actual := value.(type) {
+	case *immutableSetClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &immutableSetClass_[V]{
+			// Initialize the class constants.
+		}
+		immutableSetMap_[name] = class
+	}
+	immutableSetMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
+
+/*
+NOTE:
+The following is a private implementation of a persistent AVL tree whose nodes
+also record the size of their subtrees so that values may be accessed by their
+slot in O[log(n)] time.  Nodes are never changed once they are part of a tree.
+Instead, each change copies only the nodes on the path from the root to the
+changed node, and the rest of the nodes are shared with the original tree.  A
+nil node is an empty tree.
+*/
+
+type treeNode_[V any] struct {
+	value_  V
+	left_   *treeNode_[V]
+	right_  *treeNode_[V]
+	height_ int
+	size_   int
+}
+
+// This private function returns a balanced tree containing the specified
+// values, which must already be in order.
+func buildTree[V any](
+	values []V,
+) *treeNode_[V] {
+	if len(values) == 0 {
+		return nil
+	}
+	var middle = len(values) / 2
+	return newNode(
+		values[middle],
+		buildTree(values[:middle]),
+		buildTree(values[middle+1:]),
+	)
+}
+
+func newNode[V any](
+	value V,
+	left *treeNode_[V],
+	right *treeNode_[V],
+) *treeNode_[V] {
+	var node = &treeNode_[V]{
+		value_:  value,
+		left_:   left,
+		right_:  right,
+		height_: max(left.getHeight(), right.getHeight()) + 1,
+		size_:   left.getSize() + right.getSize() + 1,
+	}
+	return node
+}
+
+func (v *treeNode_[V]) getHeight() int {
+	if v == nil {
+		return 0
+	}
+	return v.height_
+}
+
+func (v *treeNode_[V]) getSize() int {
+	if v == nil {
+		return 0
+	}
+	return v.size_
+}
+
+func (v *treeNode_[V]) valueAt(
+	slot int,
+) V {
+	var node = v
+	for {
+		var size = node.left_.getSize()
+		switch {
+		case slot < size:
+			node = node.left_
+		case slot > size:
+			slot -= size + 1
+			node = node.right_
+		default:
+			return node.value_
+		}
+	}
+}
+
+// This private method returns the ordinal index of the specified value in the
+// tree, or zero if the value is not in the tree.
+func (v *treeNode_[V]) indexOf(
+	value V,
+	ranker age.RankingFunction[V],
+) int {
+	var index = 0
+	var node = v
+	for node != nil {
+		switch ranker(value, node.value_) {
+		case age.LesserRank:
+			node = node.left_
+		case age.GreaterRank:
+			index += node.left_.getSize() + 1
+			node = node.right_
+		default:
+			return index + node.left_.getSize() + 1
+		}
+	}
+	return 0
+}
+
+// This private method returns the value in the tree that is ranked equal to
+// the specified value, and whether or not it was found.
+func (v *treeNode_[V]) findValue(
+	value V,
+	ranker age.RankingFunction[V],
+) (result V, found bool) {
+	var node = v
+	for node != nil {
+		switch ranker(value, node.value_) {
+		case age.LesserRank:
+			node = node.left_
+		case age.GreaterRank:
+			node = node.right_
+		default:
+			return node.value_, true
+		}
+	}
+	return result, false
+}
+
+func (v *treeNode_[V]) appendValues(
+	array []V,
+) []V {
+	if v == nil {
+		return array
+	}
+	array = v.left_.appendValues(array)
+	array = append(array, v.value_)
+	return v.right_.appendValues(array)
+}
+
+// This private method returns a tree that also contains the specified value.
+// If a value that is ranked equal to it is already in the tree it is left as is
+// and the original tree is returned.
+func (v *treeNode_[V]) withValue(
+	value V,
+	ranker age.RankingFunction[V],
+) (result *treeNode_[V], changed bool) {
+	if v == nil {
+		return newNode(value, nil, nil), true
+	}
+	switch ranker(value, v.value_) {
+	case age.LesserRank:
+		var left, changed = v.left_.withValue(value, ranker)
+		if !changed {
+			return v, false
+		}
+		return rebalance(v.value_, left, v.right_), true
+	case age.GreaterRank:
+		var right, changed = v.right_.withValue(value, ranker)
+		if !changed {
+			return v, false
+		}
+		return rebalance(v.value_, v.left_, right), true
+	default:
+		return v, false
+	}
+}
+
+// This private method returns a tree in which the value that is ranked equal
+// to the specified value has been replaced by it, or added if it is missing.
+func (v *treeNode_[V]) replacingValue(
+	value V,
+	ranker age.RankingFunction[V],
+) *treeNode_[V] {
+	if v == nil {
+		return newNode(value, nil, nil)
+	}
+	switch ranker(value, v.value_) {
+	case age.LesserRank:
+		return rebalance(v.value_, v.left_.replacingValue(value, ranker), v.right_)
+	case age.GreaterRank:
+		return rebalance(v.value_, v.left_, v.right_.replacingValue(value, ranker))
+	default:
+		return newNode(value, v.left_, v.right_)
+	}
+}
+
+// This private method returns a tree that no longer contains the value that
+// is ranked equal to the specified value.  If there is no such value the
+// original tree is returned.
+func (v *treeNode_[V]) withoutValue(
+	value V,
+	ranker age.RankingFunction[V],
+) (result *treeNode_[V], changed bool) {
+	if v == nil {
+		return nil, false
+	}
+	switch ranker(value, v.value_) {
+	case age.LesserRank:
+		var left, changed = v.left_.withoutValue(value, ranker)
+		if !changed {
+			return v, false
+		}
+		return rebalance(v.value_, left, v.right_), true
+	case age.GreaterRank:
+		var right, changed = v.right_.withoutValue(value, ranker)
+		if !changed {
+			return v, false
+		}
+		return rebalance(v.value_, v.left_, right), true
+	}
+
+	// Replace the node with its successor.
+	switch {
+	case v.left_ == nil:
+		return v.right_, true
+	case v.right_ == nil:
+		return v.left_, true
+	}
+	var successor = v.right_.valueAt(0)
+	var right, _ = v.right_.withoutFirst()
+	return rebalance(successor, v.left_, right), true
+}
+
+func (v *treeNode_[V]) withoutFirst() (result *treeNode_[V], first V) {
+	if v.left_ == nil {
+		return v.right_, v.value_
+	}
+	var left, value = v.left_.withoutFirst()
+	return rebalance(v.value_, left, v.right_), value
+}
+
+// This private function returns a new node containing the specified value and
+// subtrees, rotating them as needed to restore the balance of the tree.
+func rebalance[V any](
+	value V,
+	left *treeNode_[V],
+	right *treeNode_[V],
+) *treeNode_[V] {
+	var balance = left.getHeight() - right.getHeight()
+	switch {
+	case balance > 1:
+		if left.left_.getHeight() < left.right_.getHeight() {
+			// Rotate the left subtree to the left first.
+			var pivot = left.right_
+			left = newNode(
+				pivot.value_,
+				newNode(left.value_, left.left_, pivot.left_),
+				pivot.right_,
+			)
+		}
+		// Rotate to the right.
+		return newNode(
+			left.value_,
+			left.left_,
+			newNode(value, left.right_, right),
+		)
+	case balance < -1:
+		if right.right_.getHeight() < right.left_.getHeight() {
+			// Rotate the right subtree to the right first.
+			var pivot = right.left_
+			right = newNode(
+				pivot.value_,
+				pivot.left_,
+				newNode(right.value_, pivot.right_, right.right_),
+			)
+		}
+		// Rotate to the left.
+		return newNode(
+			right.value_,
+			newNode(value, left, right.left_),
+			right.right_,
+		)
+	default:
+		return newNode(value, left, right)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	fmt "fmt"
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	uti "github.com/craterdog/go-missing-utilities/v8"
+	rnd "math/rand/v2"
 	syn "sync"
 )
 
@@ -30,8 +31,21 @@ func ListClass[V any]() ListClassLike[V] {
 // Constructor Methods
 
 func (c *listClass_[V]) List() ListLike[V] {
+	var collator = age.CollatorClass[V]().Collator()
+	var instance = c.ListWithCollator(collator)
+	return instance
+}
+
+func (c *listClass_[V]) ListWithCollator(
+	collator age.CollatorLike[V],
+) ListLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
 	var instance = &list_[V]{
-		array_: []V{},
+		// Initialize the instance attributes.
+		collator_: collator,
+		array_:    []V{},
 	}
 	return instance
 }
@@ -40,7 +54,9 @@ func (c *listClass_[V]) ListFromArray(
 	values []V,
 ) ListLike[V] {
 	var instance = &list_[V]{
-		array_: uti.CopyArray(values),
+		// Initialize the instance attributes.
+		collator_: age.CollatorClass[V]().Collator(),
+		array_:    uti.CopyArray(values),
 	}
 	return instance
 }
@@ -49,7 +65,9 @@ func (c *listClass_[V]) ListFromSequence(
 	values Sequential[V],
 ) ListLike[V] {
 	var instance = &list_[V]{
-		array_: values.AsArray(),
+		// Initialize the instance attributes.
+		collator_: age.CollatorClass[V]().Collator(),
+		array_:    values.AsArray(),
 	}
 	return instance
 }
@@ -62,11 +80,24 @@ func (c *listClass_[V]) Concatenate(
 	first ListLike[V],
 	second ListLike[V],
 ) ListLike[V] {
-	var list = c.ListFromSequence(first)
+	var list = c.ListWithCollator(first.GetCollator())
+	list.AppendValues(first)
 	list.AppendValues(second)
 	return list
 }
 
+func (c *listClass_[V]) ReadOnly(
+	list ListLike[V],
+) ListViewLike[V] {
+	if uti.IsUndefined(list) {
+		panic("The \"list\" attribute is required by this class.")
+	}
+	var view = &listView_[V]{
+		list_: list,
+	}
+	return view
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -75,8 +106,20 @@ func (v *list_[V]) GetClass() ListClassLike[V] {
 	return listClass[V]()
 }
 
+func (v *list_[V]) Begin() ListTransactionLike[V] {
+	return v.newTransaction(false)
+}
+
+func (v *list_[V]) BeginWithHistory() ListTransactionLike[V] {
+	return v.newTransaction(true)
+}
+
 // Attribute Methods
 
+func (v *list_[V]) GetCollator() age.CollatorLike[V] {
+	return v.collator_
+}
+
 // Accessible[V] Methods
 
 func (v *list_[V]) GetValue(
@@ -95,34 +138,92 @@ func (v *list_[V]) GetValues(
 	var size = v.GetSize()
 	var goFirst = uti.RelativeToCardinal(first, size)
 	var goLast = uti.RelativeToCardinal(last, size) + 1
-	var values = listClass[V]().ListFromArray(v.array_[goFirst:goLast])
+	var values = v.newList(uti.CopyArray(v.array_[goFirst:goLast]))
 	return values
 }
 
 func (v *list_[V]) GetIndex(
 	value V,
 ) int {
-	var index int
-	var collator = age.CollatorClass[V]().Collator()
-	var iterator = v.GetIterator()
-	for iterator.HasNext() {
-		index++
-		var candidate = iterator.GetNext()
-		if collator.CompareValues(candidate, value) {
+	for index, candidate := range v.array_ {
+		if v.collator_.CompareValues(candidate, value) {
 			// Found the value.
-			return index
+			return index + 1
 		}
 	}
 	// The value was not found.
 	return 0
 }
 
+// Bisectable[V] Methods
+
+func (v *list_[V]) SearchValue(
+	value V,
+	ranker age.RankingFunction[V],
+) (
+	index int,
+	found bool,
+) {
+	var slot = v.LowerBound(value, ranker)
+	if slot < v.GetSize() && ranker(value, v.array_[slot]) == age.EqualRank {
+		// The value following the slot is equal to the value.
+		return int(slot) + 1, true
+	}
+	return int(slot), false
+}
+
+func (v *list_[V]) LowerBound(
+	value V,
+	ranker age.RankingFunction[V],
+) uint {
+	// We use iteration instead of recursion for better performance.
+	var low = 0
+	var high = len(v.array_)
+	for low < high {
+		var middle = int(uint(low+high) >> 1) // Avoids overflowing.
+		if ranker(v.array_[middle], value) == age.LesserRank {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return uint(low)
+}
+
+func (v *list_[V]) UpperBound(
+	value V,
+	ranker age.RankingFunction[V],
+) uint {
+	// We use iteration instead of recursion for better performance.
+	var low = 0
+	var high = len(v.array_)
+	for low < high {
+		var middle = int(uint(low+high) >> 1) // Avoids overflowing.
+		if ranker(value, v.array_[middle]) == age.LesserRank {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+	return uint(low)
+}
+
+func (v *list_[V]) InsertSorted(
+	value V,
+	ranker age.RankingFunction[V],
+) {
+	v.checkUnlocked()
+	var slot = v.UpperBound(value, ranker)
+	v.InsertValue(slot, value)
+}
+
 // Malleable[V] Methods
 
 func (v *list_[V]) InsertValue(
 	slot uint,
 	value V,
 ) {
+	v.checkUnlocked()
 	// Create a new larger array.
 	var size = v.GetSize() + 1
 	var array = make([]V, size)
@@ -134,12 +235,15 @@ func (v *list_[V]) InsertValue(
 
 	// Update the internal array.
 	v.array_ = array
+	var index = int(slot) + 1
+	v.observers_.notify(Inserted, index, index, []V{value}, nil)
 }
 
 func (v *list_[V]) InsertValues(
 	slot uint,
 	values Sequential[V],
 ) {
+	v.checkUnlocked()
 	// Create a new larger array.
 	var newValues = values.AsArray()
 	var delta = uti.ArraySize(newValues)
@@ -153,11 +257,17 @@ func (v *list_[V]) InsertValues(
 
 	// Update the internal array.
 	v.array_ = array
+	if delta > 0 {
+		var first = int(slot) + 1
+		var last = int(slot + delta)
+		v.observers_.notify(Inserted, first, last, newValues, nil)
+	}
 }
 
 func (v *list_[V]) AppendValue(
 	value V,
 ) {
+	v.checkUnlocked()
 	// Create a new larger array.
 	var size = len(v.array_) + 1
 	var array = make([]V, size)
@@ -168,11 +278,13 @@ func (v *list_[V]) AppendValue(
 
 	// Update the internal array.
 	v.array_ = array
+	v.observers_.notify(Inserted, size, size, []V{value}, nil)
 }
 
 func (v *list_[V]) AppendValues(
 	values Sequential[V],
 ) {
+	v.checkUnlocked()
 	// Create a new larger array.
 	var newValues = values.AsArray()
 	var size = len(v.array_) + len(newValues)
@@ -183,12 +295,17 @@ func (v *list_[V]) AppendValues(
 	copy(array[len(v.array_):], newValues)
 
 	// Update the internal array.
+	var first = len(v.array_) + 1
 	v.array_ = array
+	if len(newValues) > 0 {
+		v.observers_.notify(Inserted, first, size, newValues, nil)
+	}
 }
 
 func (v *list_[V]) RemoveValue(
 	index int,
 ) V {
+	v.checkUnlocked()
 	// Convert to zero-based index.
 	var size = v.GetSize()
 	var slot = uti.RelativeToCardinal(index, size)
@@ -204,6 +321,8 @@ func (v *list_[V]) RemoveValue(
 
 	// Update the internal array.
 	v.array_ = array
+	var first = int(slot) + 1
+	v.observers_.notify(Removed, first, first, nil, []V{removed})
 	return removed
 }
 
@@ -211,6 +330,7 @@ func (v *list_[V]) RemoveValues(
 	first int,
 	last int,
 ) Sequential[V] {
+	v.checkUnlocked()
 	// Create two smaller arrays.
 	var size = v.GetSize()
 	var goFirst = uti.RelativeToCardinal(first, size)
@@ -227,14 +347,34 @@ func (v *list_[V]) RemoveValues(
 
 	// Update the internal array.
 	v.array_ = array
+	v.observers_.notify(Removed, int(goFirst)+1, int(goLast), nil, removed)
 
 	// Return a list of the removed values.
-	var values = listClass[V]().ListFromArray(removed)
+	var values = v.newList(removed)
 	return values
 }
 
 func (v *list_[V]) RemoveAll() {
+	v.checkUnlocked()
+	var removed = v.array_
 	v.array_ = []V{}
+	if len(removed) > 0 {
+		v.observers_.notify(Removed, 1, len(removed), nil, removed)
+	}
+}
+
+// Observable[V] Methods
+
+func (v *list_[V]) Subscribe(
+	listener ListenerFunction[V],
+) Subscription {
+	return v.observers_.subscribe(listener)
+}
+
+func (v *list_[V]) Batch(
+	function func(),
+) {
+	v.observers_.batch(function)
 }
 
 // Searchable[V] Methods
@@ -275,6 +415,39 @@ func (v *list_[V]) ContainsAll(
 	return true
 }
 
+// Serializable Methods
+
+func (v *list_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeCollator(v.collator_)
+	encoder.EncodeValue(uti.ArraySize(v.array_))
+	for _, value := range v.array_ {
+		encoder.EncodeValue(value)
+	}
+}
+
+func (v *list_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	v.checkUnlocked()
+	var collator, ok = decoder.DecodeCollator().(age.CollatorLike[V])
+	if !ok {
+		collator = age.CollatorClass[V]().Collator()
+	}
+	var size uint
+	if decoder.DecodeValue(&size) != nil {
+		return
+	}
+
+	var array, decoded = listClass[V]().decodeArray(decoder, size)
+	if !decoded {
+		return
+	}
+	v.collator_ = collator
+	v.array_ = array
+}
+
 // Sequential[V] Methods
 
 func (v *list_[V]) IsEmpty() bool {
@@ -299,25 +472,74 @@ func (v *list_[V]) GetIterator() uti.IteratorLike[V] {
 // Sortable[V] Methods
 
 func (v *list_[V]) SortValues() {
-	var sorter = age.SorterClass[V]().Sorter()
+	v.checkUnlocked()
+	var ranker = v.collator_.RankValues
+	var sorter = age.SorterClass[V]().SorterWithRanker(ranker)
 	sorter.SortValues(v.array_)
+	v.notifyReordered()
 }
 
 func (v *list_[V]) SortValuesWithRanker(
 	ranker age.RankingFunction[V],
 ) {
+	v.checkUnlocked()
 	var sorter = age.SorterClass[V]().SorterWithRanker(ranker)
 	sorter.SortValues(v.array_)
+	v.notifyReordered()
 }
 
 func (v *list_[V]) ReverseValues() {
+	v.checkUnlocked()
 	var sorter = age.SorterClass[V]().Sorter()
 	sorter.ReverseValues(v.array_)
+	v.notifyReordered()
 }
 
 func (v *list_[V]) ShuffleValues() {
+	v.checkUnlocked()
 	var sorter = age.SorterClass[V]().Sorter()
 	sorter.ShuffleValues(v.array_)
+	v.notifyReordered()
+}
+
+func (v *list_[V]) ShuffleValuesWithSource(
+	source rnd.Source,
+) {
+	v.checkUnlocked()
+	var ranker = v.collator_.RankValues
+	var sorter = age.SorterClass[V]().SorterWithRandomSource(ranker, source)
+	sorter.ShuffleValues(v.array_)
+	v.notifyReordered()
+}
+
+func (v *list_[V]) SelectValue(
+	k uint,
+	ranker age.RankingFunction[V],
+) V {
+	v.checkUnlocked()
+	var sorter = age.SorterClass[V]().SorterWithRanker(ranker)
+	var value = sorter.SelectValue(v.array_, k)
+	v.notifyReordered()
+	return value
+}
+
+func (v *list_[V]) PartialSort(
+	k uint,
+	ranker age.RankingFunction[V],
+) {
+	v.checkUnlocked()
+	var sorter = age.SorterClass[V]().SorterWithRanker(ranker)
+	sorter.PartialSort(v.array_, k)
+	v.notifyReordered()
+}
+
+func (v *list_[V]) TopValues(
+	k uint,
+	ranker age.RankingFunction[V],
+) Sequential[V] {
+	var sorter = age.SorterClass[V]().SorterWithRanker(ranker)
+	var values = sorter.TopValues(v.array_, k)
+	return v.newList(values)
 }
 
 // Updatable[V] Methods
@@ -326,19 +548,35 @@ func (v *list_[V]) SetValue(
 	index int,
 	value V,
 ) {
+	v.checkUnlocked()
 	var size = v.GetSize()
 	var slot = uti.RelativeToCardinal(index, size)
+	var previous = v.array_[slot]
 	v.array_[slot] = value
+	var first = int(slot) + 1
+	v.observers_.notify(Replaced, first, first, []V{value}, []V{previous})
 }
 
 func (v *list_[V]) SetValues(
 	index int,
 	values Sequential[V],
 ) {
+	v.checkUnlocked()
 	var size = v.GetSize()
 	var slot = uti.RelativeToCardinal(index, size)
 	var newValues = values.AsArray()
-	copy(v.array_[slot:], newValues)
+	var previous = v.array_[slot:]
+	if v.observers_.isObserved() {
+		// The previous values must be captured before they are replaced.
+		previous = uti.CopyArray(previous)
+	}
+	var count = copy(v.array_[slot:], newValues)
+	if count > 0 {
+		var first = int(slot) + 1
+		var last = int(slot) + count
+		var replaced = v.array_[slot : int(slot)+count]
+		v.observers_.notify(Replaced, first, last, replaced, previous[:count])
+	}
 }
 
 // PROTECTED INTERFACE
@@ -349,11 +587,85 @@ func (v *list_[V]) String() string {
 
 // Private Methods
 
+// This private class method decodes the specified number of values one at a time
+// directly into a new array.  It returns false if the values could not be
+// decoded.
+func (c *listClass_[V]) decodeArray(
+	decoder age.DecoderLike,
+	size uint,
+) (
+	array []V,
+	ok bool,
+) {
+	// The size is not trusted when allocating the array.
+	array = make([]V, 0, min(size, 1024))
+	for range size {
+		var value V
+		if decoder.DecodeValue(&value) != nil {
+			return
+		}
+		array = append(array, value)
+	}
+	return array, true
+}
+
+// This private instance method returns a new list containing the specified Go
+// array (without copying it) that uses the same collator as this list.
+func (v *list_[V]) newList(
+	array []V,
+) ListLike[V] {
+	var list = &list_[V]{
+		// Initialize the instance attributes.
+		collator_: v.collator_,
+		array_:    array,
+	}
+	return list
+}
+
+// This private instance method panics if a transaction is in progress on this
+// list, since any direct change to the list would be lost when it commits.
+func (v *list_[V]) checkUnlocked() {
+	if v.locked_ {
+		panic("The list cannot be changed directly while a transaction is in progress.")
+	}
+}
+
+// This private instance method returns a new transaction on this list that
+// makes its changes to a private copy of the list.  The list is locked until
+// the transaction is committed or rolled back.
+func (v *list_[V]) newTransaction(
+	history bool,
+) ListTransactionLike[V] {
+	if v.locked_ {
+		panic("A transaction is already in progress on this list.")
+	}
+	v.locked_ = true
+	var transaction = &listTransaction_[V]{
+		list_:    v,
+		staged_:  &list_[V]{collator_: v.collator_, array_: uti.CopyArray(v.array_)},
+		history_: history,
+	}
+	transaction.staged_.Subscribe(func(events Sequential[EventLike[V]]) {
+		transaction.events_ = append(transaction.events_, events.AsArray()...)
+	})
+	return transaction
+}
+
+func (v *list_[V]) notifyReordered() {
+	var size = len(v.array_)
+	if size > 0 {
+		v.observers_.notify(Reordered, 1, size, v.array_, nil)
+	}
+}
+
 // Instance Structure
 
 type list_[V any] struct {
 	// Declare the instance attributes.
-	array_ []V
+	collator_  age.CollatorLike[V]
+	array_     []V
+	observers_ observers_[V]
+	locked_    bool
 }
 
 // Class Structure
@@ -385,9 +697,373 @@ func listClass[V any]() *listClass_[V] {
 			// Initialize the class constants.
 		}
 		listMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&list_[V]{})
 	}
 	listMutex_.Unlock()
 
 	// Return a reference to the bound class type.
 	return class
 }
+
+/*
+NOTE:
+The following is a private implementation of a read-only view of a list.  It
+delegates each query to the list itself so that it always reflects the current
+state of the list.  Since the list is never exposed by the view, it cannot be
+changed through the view.
+*/
+
+type listView_[V any] struct {
+	list_ ListLike[V]
+}
+
+func (v *listView_[V]) AsList() ListLike[V] {
+	var list = listClass[V]().ListWithCollator(v.list_.GetCollator())
+	list.AppendValues(v.list_)
+	return list
+}
+
+func (v *listView_[V]) GetCollator() age.CollatorLike[V] {
+	return v.list_.GetCollator()
+}
+
+func (v *listView_[V]) GetValue(
+	index int,
+) V {
+	return v.list_.GetValue(index)
+}
+
+func (v *listView_[V]) GetValues(
+	first int,
+	last int,
+) Sequential[V] {
+	return v.list_.GetValues(first, last)
+}
+
+func (v *listView_[V]) GetIndex(
+	value V,
+) int {
+	return v.list_.GetIndex(value)
+}
+
+func (v *listView_[V]) ContainsValue(
+	value V,
+) bool {
+	return v.list_.ContainsValue(value)
+}
+
+func (v *listView_[V]) ContainsAny(
+	values Sequential[V],
+) bool {
+	return v.list_.ContainsAny(values)
+}
+
+func (v *listView_[V]) ContainsAll(
+	values Sequential[V],
+) bool {
+	return v.list_.ContainsAll(values)
+}
+
+func (v *listView_[V]) IsEmpty() bool {
+	return v.list_.IsEmpty()
+}
+
+func (v *listView_[V]) GetSize() uint {
+	return v.list_.GetSize()
+}
+
+func (v *listView_[V]) AsArray() []V {
+	return v.list_.AsArray()
+}
+
+func (v *listView_[V]) GetIterator() uti.IteratorLike[V] {
+	return v.list_.GetIterator()
+}
+
+func (v *listView_[V]) String() string {
+	return uti.Format(v)
+}
+
+/*
+NOTE:
+The following is a private implementation of a transaction on a list.  The
+changes made through the transaction are applied to a private copy of the list
+and the events generated by the copy are collected so that they can be passed
+to the listeners of the list when the transaction is committed.  When history
+is requested each change is recorded along with the change that reverts it.
+*/
+
+type listTransaction_[V any] struct {
+	list_    *list_[V]
+	staged_  *list_[V]
+	events_  []EventLike[V]
+	history_ bool
+	undos_   []listEdit_
+	redos_   []listEdit_
+	ended_   bool
+}
+
+type listEdit_ struct {
+	apply_  func()
+	revert_ func()
+}
+
+func (v *listTransaction_[V]) Commit() {
+	v.end()
+	v.list_.array_ = v.staged_.array_
+	if len(v.events_) > 0 && v.list_.observers_.isObserved() {
+		v.list_.observers_.publish(v.events_)
+	}
+}
+
+func (v *listTransaction_[V]) Rollback() {
+	v.end()
+}
+
+func (v *listTransaction_[V]) Undo() {
+	v.checkActive()
+	var last = len(v.undos_) - 1
+	if last < 0 {
+		return
+	}
+	var edit = v.undos_[last]
+	v.undos_ = v.undos_[:last]
+	edit.revert_()
+	v.redos_ = append(v.redos_, edit)
+}
+
+func (v *listTransaction_[V]) Redo() {
+	v.checkActive()
+	var last = len(v.redos_) - 1
+	if last < 0 {
+		return
+	}
+	var edit = v.redos_[last]
+	v.redos_ = v.redos_[:last]
+	edit.apply_()
+	v.undos_ = append(v.undos_, edit)
+}
+
+func (v *listTransaction_[V]) CanUndo() bool {
+	return len(v.undos_) > 0
+}
+
+func (v *listTransaction_[V]) CanRedo() bool {
+	return len(v.redos_) > 0
+}
+
+func (v *listTransaction_[V]) GetValue(
+	index int,
+) V {
+	v.checkActive()
+	return v.staged_.GetValue(index)
+}
+
+func (v *listTransaction_[V]) GetValues(
+	first int,
+	last int,
+) Sequential[V] {
+	v.checkActive()
+	return v.staged_.GetValues(first, last)
+}
+
+func (v *listTransaction_[V]) GetIndex(
+	value V,
+) int {
+	v.checkActive()
+	return v.staged_.GetIndex(value)
+}
+
+func (v *listTransaction_[V]) InsertValue(
+	slot uint,
+	value V,
+) {
+	v.record(
+		func() { v.staged_.InsertValue(slot, value) },
+		func() { v.staged_.RemoveValue(int(slot) + 1) },
+	)
+}
+
+func (v *listTransaction_[V]) InsertValues(
+	slot uint,
+	values Sequential[V],
+) {
+	var array = values.AsArray()
+	var count = len(array)
+	v.record(
+		func() { v.staged_.InsertValues(slot, v.staged_.newList(array)) },
+		func() {
+			if count > 0 {
+				var first = int(slot) + 1
+				v.staged_.RemoveValues(first, first+count-1)
+			}
+		},
+	)
+}
+
+func (v *listTransaction_[V]) AppendValue(
+	value V,
+) {
+	v.record(
+		func() { v.staged_.AppendValue(value) },
+		func() { v.staged_.RemoveValue(-1) },
+	)
+}
+
+func (v *listTransaction_[V]) AppendValues(
+	values Sequential[V],
+) {
+	var array = values.AsArray()
+	var count = len(array)
+	v.record(
+		func() { v.staged_.AppendValues(v.staged_.newList(array)) },
+		func() {
+			if count > 0 {
+				v.staged_.RemoveValues(-count, -1)
+			}
+		},
+	)
+}
+
+func (v *listTransaction_[V]) RemoveValue(
+	index int,
+) V {
+	v.checkActive()
+	var slot = uti.RelativeToCardinal(index, v.staged_.GetSize())
+	var removed V
+	v.record(
+		func() { removed = v.staged_.RemoveValue(int(slot) + 1) },
+		func() { v.staged_.InsertValue(uint(slot), removed) },
+	)
+	return removed
+}
+
+func (v *listTransaction_[V]) RemoveValues(
+	first int,
+	last int,
+) Sequential[V] {
+	v.checkActive()
+	var size = v.staged_.GetSize()
+	var slot = uti.RelativeToCardinal(first, size)
+	var count = int(uti.RelativeToCardinal(last, size)-slot) + 1
+	var removed Sequential[V]
+	v.record(
+		func() {
+			removed = v.staged_.RemoveValues(int(slot)+1, int(slot)+count)
+		},
+		func() { v.staged_.InsertValues(uint(slot), removed) },
+	)
+	return removed
+}
+
+func (v *listTransaction_[V]) RemoveAll() {
+	var removed []V
+	v.record(
+		func() {
+			removed = v.staged_.array_
+			v.staged_.RemoveAll()
+		},
+		func() { v.staged_.AppendValues(v.staged_.newList(removed)) },
+	)
+}
+
+func (v *listTransaction_[V]) ContainsValue(
+	value V,
+) bool {
+	v.checkActive()
+	return v.staged_.ContainsValue(value)
+}
+
+func (v *listTransaction_[V]) ContainsAny(
+	values Sequential[V],
+) bool {
+	v.checkActive()
+	return v.staged_.ContainsAny(values)
+}
+
+func (v *listTransaction_[V]) ContainsAll(
+	values Sequential[V],
+) bool {
+	v.checkActive()
+	return v.staged_.ContainsAll(values)
+}
+
+func (v *listTransaction_[V]) IsEmpty() bool {
+	v.checkActive()
+	return v.staged_.IsEmpty()
+}
+
+func (v *listTransaction_[V]) GetSize() uint {
+	v.checkActive()
+	return v.staged_.GetSize()
+}
+
+func (v *listTransaction_[V]) AsArray() []V {
+	v.checkActive()
+	return v.staged_.AsArray()
+}
+
+func (v *listTransaction_[V]) GetIterator() uti.IteratorLike[V] {
+	v.checkActive()
+	return v.staged_.GetIterator()
+}
+
+func (v *listTransaction_[V]) SetValue(
+	index int,
+	value V,
+) {
+	v.checkActive()
+	var slot = uti.RelativeToCardinal(index, v.staged_.GetSize())
+	var previous = v.staged_.array_[slot]
+	v.record(
+		func() { v.staged_.SetValue(int(slot)+1, value) },
+		func() { v.staged_.SetValue(int(slot)+1, previous) },
+	)
+}
+
+func (v *listTransaction_[V]) SetValues(
+	index int,
+	values Sequential[V],
+) {
+	v.checkActive()
+	var slot = uti.RelativeToCardinal(index, v.staged_.GetSize())
+	var array = values.AsArray()
+	var count = min(len(array), len(v.staged_.array_)-int(slot))
+	var previous = uti.CopyArray(v.staged_.array_[slot : int(slot)+count])
+	v.record(
+		func() { v.staged_.SetValues(int(slot)+1, v.staged_.newList(array)) },
+		func() { v.staged_.SetValues(int(slot)+1, v.staged_.newList(previous)) },
+	)
+}
+
+func (v *listTransaction_[V]) String() string {
+	return uti.Format(v)
+}
+
+// This private method applies a change to the private copy of the list and,
+// when history is requested, records it so that it can be undone.
+func (v *listTransaction_[V]) record(
+	apply func(),
+	revert func(),
+) {
+	v.checkActive()
+	apply()
+	if v.history_ {
+		v.undos_ = append(v.undos_, listEdit_{apply_: apply, revert_: revert})
+		v.redos_ = nil
+	}
+}
+
+func (v *listTransaction_[V]) checkActive() {
+	if v.ended_ {
+		panic("The transaction has already been committed or rolled back.")
+	}
+}
+
+func (v *listTransaction_[V]) end() {
+	v.checkActive()
+	v.ended_ = true
+	v.list_.locked_ = false
+}
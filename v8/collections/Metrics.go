@@ -0,0 +1,233 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	mps "maps"
+	sli "slices"
+	syn "sync"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func MetricsClass() MetricsClassLike {
+	return metricsClass()
+}
+
+// Constructor Methods
+
+func (c *metricsClass_) Metrics(
+	name string,
+	capacity uint,
+	enqueued uint64,
+	dequeued uint64,
+	depth uint,
+	highWaterMark uint,
+	producerBlocked tim.Duration,
+	consumerBlocked tim.Duration,
+) MetricsLike {
+	var instance = &metrics_{
+		// Initialize the instance attributes.
+		name_:            name,
+		capacity_:        capacity,
+		enqueued_:        enqueued,
+		dequeued_:        dequeued,
+		depth_:           depth,
+		highWaterMark_:   highWaterMark,
+		producerBlocked_: producerBlocked,
+		consumerBlocked_: consumerBlocked,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *metricsClass_) Snapshot() Sequential[MetricsLike] {
+	var snapshot = ListClass[MetricsLike]().List()
+	c.mutex_.Lock()
+	var keys = sli.Sorted(mps.Keys(c.measurables_)) // The order of registration.
+	for _, key := range keys {
+		// Any measurables that are no longer referenced are skipped until they
+		// are unregistered.
+		var instance = c.measurables_[key]()
+		if instance != nil {
+			snapshot.AppendValue(instance.GetMetrics())
+		}
+	}
+	c.mutex_.Unlock()
+	return snapshot
+}
+
+func (c *metricsClass_) Exporter() fmt.Stringer {
+	return exporter_{}
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *metrics_) GetClass() MetricsClassLike {
+	return metricsClass()
+}
+
+// Attribute Methods
+
+func (v *metrics_) GetName() string {
+	return v.name_
+}
+
+func (v *metrics_) GetCapacity() uint {
+	return v.capacity_
+}
+
+func (v *metrics_) GetEnqueued() uint64 {
+	return v.enqueued_
+}
+
+func (v *metrics_) GetDequeued() uint64 {
+	return v.dequeued_
+}
+
+func (v *metrics_) GetDepth() uint {
+	return v.depth_
+}
+
+func (v *metrics_) GetHighWaterMark() uint {
+	return v.highWaterMark_
+}
+
+func (v *metrics_) GetProducerBlocked() tim.Duration {
+	return v.producerBlocked_
+}
+
+func (v *metrics_) GetConsumerBlocked() tim.Duration {
+	return v.consumerBlocked_
+}
+
+// PROTECTED INTERFACE
+
+func (v *metrics_) String() string {
+	return uti.Format(v)
+}
+
+// Private Methods
+
+// This private method adds a function that returns a measurable instance, or
+// nil once the instance is no longer referenced, to the registry and returns the
+// key that must be used to unregister it.
+func (c *metricsClass_) register(
+	measurable func() Measurable,
+) uint64 {
+	c.mutex_.Lock()
+	var key = c.nextKey_
+	c.nextKey_++
+	c.measurables_[key] = measurable
+	c.mutex_.Unlock()
+	return key
+}
+
+// This private method removes the measurable with the specified key from the
+// registry.
+func (c *metricsClass_) unregister(
+	key uint64,
+) {
+	c.mutex_.Lock()
+	delete(c.measurables_, key)
+	c.mutex_.Unlock()
+}
+
+// Instance Structure
+
+type metrics_ struct {
+	// Declare the instance attributes.
+	name_            string
+	capacity_        uint
+	enqueued_        uint64
+	dequeued_        uint64
+	depth_           uint
+	highWaterMark_   uint
+	producerBlocked_ tim.Duration
+	consumerBlocked_ tim.Duration
+}
+
+// Class Structure
+
+type metricsClass_ struct {
+	// Declare the class constants.
+	mutex_       syn.Mutex
+	nextKey_     uint64
+	measurables_ map[uint64]func() Measurable
+}
+
+// Class Reference
+
+func metricsClass() *metricsClass_ {
+	return metricsClassReference_
+}
+
+var metricsClassReference_ = &metricsClass_{
+	// Initialize the class constants.
+	measurables_: map[uint64]func() Measurable{},
+}
+
+/*
+NOTE:
+The following is a private implementation of the exporter that is returned by
+the Exporter() class function.  Since it implements the String() method by
+returning a JSON array of the current metrics, it satisfies the Var interface
+of the "expvar" package and can be published directly:
+
+	expvar.Publish("queues", fra.MetricsClass().Exporter())
+*/
+
+type exporter_ struct{}
+
+func (v exporter_) String() string {
+	var snapshot = metricsClass().Snapshot()
+	var records = []metricsRecord_{}
+	var iterator = snapshot.GetIterator()
+	for iterator.HasNext() {
+		var metrics = iterator.GetNext()
+		records = append(records, metricsRecord_{
+			Name:            metrics.GetName(),
+			Capacity:        metrics.GetCapacity(),
+			Enqueued:        metrics.GetEnqueued(),
+			Dequeued:        metrics.GetDequeued(),
+			Depth:           metrics.GetDepth(),
+			HighWaterMark:   metrics.GetHighWaterMark(),
+			ProducerBlocked: metrics.GetProducerBlocked().Seconds(),
+			ConsumerBlocked: metrics.GetConsumerBlocked().Seconds(),
+		})
+	}
+	var bytes, _ = jsn.Marshal(records)
+	return string(bytes)
+}
+
+type metricsRecord_ struct {
+	Name            string  `json:"name"`
+	Capacity        uint    `json:"capacity"`
+	Enqueued        uint64  `json:"enqueued"`
+	Dequeued        uint64  `json:"dequeued"`
+	Depth           uint    `json:"depth"`
+	HighWaterMark   uint    `json:"highWaterMark"`
+	ProducerBlocked float64 `json:"producerBlockedSeconds"`
+	ConsumerBlocked float64 `json:"consumerBlockedSeconds"`
+}
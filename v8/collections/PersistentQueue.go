@@ -0,0 +1,713 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	byt "bytes"
+	cmp "cmp"
+	bin "encoding/binary"
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	crc "hash/crc32"
+	mps "maps"
+	osx "os"
+	pat "path/filepath"
+	sli "slices"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func PersistentQueueClass[V any]() PersistentQueueClassLike[V] {
+	return persistentQueueClass[V]()
+}
+
+// Constructor Methods
+
+func (c *persistentQueueClass_[V]) PersistentQueue(
+	directory string,
+) PersistentQueueLike[V] {
+	var instance = c.PersistentQueueWithCapacity(directory, 0) // Request the default capacity.
+	return instance
+}
+
+func (c *persistentQueueClass_[V]) PersistentQueueWithCapacity(
+	directory string,
+	capacity uint,
+) PersistentQueueLike[V] {
+	var encoder = func(value V) []byte {
+		var buffer byt.Buffer
+		var err = age.EncoderClass().Encoder(&buffer).EncodeValue(value)
+		if err != nil {
+			panic(err.Error())
+		}
+		return buffer.Bytes()
+	}
+	var decoder = func(bytes []byte) V {
+		var value V
+		var err = age.DecoderClass().Decoder(byt.NewReader(bytes)).DecodeValue(&value)
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
+	var instance = c.PersistentQueueWithCodec(directory, capacity, encoder, decoder)
+	return instance
+}
+
+func (c *persistentQueueClass_[V]) PersistentQueueWithCodec(
+	directory string,
+	capacity uint,
+	encoder EncodingFunction[V],
+	decoder DecodingFunction[V],
+) PersistentQueueLike[V] {
+	if uti.IsUndefined(directory) {
+		panic("The \"directory\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(encoder) {
+		panic("The \"encoder\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(decoder) {
+		panic("The \"decoder\" attribute is required by this class.")
+	}
+	if capacity < 1 {
+		capacity = 16 // This is the default capacity.
+	}
+	var instance = &persistentQueue_[V]{
+		// Initialize the instance attributes.
+		directory_: directory,
+		capacity_:  capacity,
+		encoder_:   encoder,
+		decoder_:   decoder,
+		closing_:   make(chan bool),
+	}
+	instance.changed_ = syn.NewCond(&instance.mutex_)
+	instance.unacknowledged_ = map[uint64]*queueEntry_[V]{}
+	instance.lockDirectory()
+	instance.replayLog()
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *persistentQueue_[V]) GetClass() PersistentQueueClassLike[V] {
+	return persistentQueueClass[V]()
+}
+
+func (v *persistentQueue_[V]) RemoveFirstWithReceipt() (
+	first V,
+	receipt uint64,
+	ok bool,
+) {
+	// Remove the first value from the queue if one exists.
+	_, ok = <-v.available_ // Will block until a value is available.
+	if ok {
+		v.mutex_.Lock()
+		var entry = v.removeEntry()
+		if v.released_ {
+			// The value remains in the log for the next time it is opened.
+			ok = false
+		} else {
+			v.unacknowledged_[entry.sequence_] = entry
+			first = entry.value_
+			receipt = entry.sequence_
+		}
+		v.mutex_.Unlock()
+	}
+	return
+}
+
+func (v *persistentQueue_[V]) Acknowledge(
+	receipt uint64,
+) bool {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	var entry, ok = v.unacknowledged_[receipt]
+	if ok {
+		delete(v.unacknowledged_, receipt)
+		v.acknowledgeEntry(entry)
+	}
+	return ok
+}
+
+func (v *persistentQueue_[V]) Compact() {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	if v.released_ {
+		return
+	}
+
+	// Rewrite the remaining values in the order they were added.
+	var entries = sli.AppendSeq(sli.Clone(v.pending_), mps.Values(v.unacknowledged_))
+	sli.SortFunc(entries, persistentQueueClass[V]().compareEntries)
+	var obsolete = v.segments_
+	v.segments_ = nil
+	var segment = v.createSegment(obsolete[len(obsolete)-1].number_ + 1)
+	for _, entry := range entries {
+		v.writeRecord(persistentQueueClass[V]().valueRecord_, entry.sequence_, entry.bytes_)
+		entry.segment_ = segment
+		segment.remaining_++
+	}
+	v.syncSegment()
+	for _, old := range obsolete {
+		v.deleteSegment(old)
+	}
+}
+
+func (v *persistentQueue_[V]) Close() {
+	// The values that remain in the queue are left in the log for the next time
+	// it is opened.
+	v.CloseChannel()
+	var count = v.claimAvailable()
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	for range count {
+		v.removeEntry()
+	}
+	if v.released_ {
+		return
+	}
+	v.released_ = true
+	clear(v.unacknowledged_)
+	for _, segment := range v.segments_ {
+		if segment.file_ != nil {
+			v.checkError(segment.file_.Close())
+			segment.file_ = nil
+		}
+	}
+	v.unlockDirectory()
+}
+
+// Attribute Methods
+
+func (v *persistentQueue_[V]) GetDirectory() string {
+	return v.directory_
+}
+
+func (v *persistentQueue_[V]) GetCapacity() uint {
+	return v.capacity_
+}
+
+func (v *persistentQueue_[V]) GetUnacknowledged() uint {
+	v.mutex_.Lock()
+	var count = uint(len(v.unacknowledged_))
+	v.mutex_.Unlock()
+	return count
+}
+
+// Fifo[V] Methods
+
+func (v *persistentQueue_[V]) AddValue(
+	value V,
+) bool {
+	var bytes = v.encoder_(value)
+	v.mutex_.Lock()
+	if v.closed_ {
+		v.mutex_.Unlock()
+		return false
+	}
+	v.senders_++
+	v.mutex_.Unlock()
+
+	// The availability of the value is signaled before the value is logged so
+	// that a producer that is blocked when the queue is closed has nothing to
+	// withdraw.
+	var added = true
+	select {
+	case v.available_ <- true: // The queue will block if at capacity.
+	case <-v.closing_:
+		added = false
+	}
+	v.mutex_.Lock()
+	if added {
+		var sequence = v.sequence_
+		v.sequence_++
+		v.writeRecord(persistentQueueClass[V]().valueRecord_, sequence, bytes)
+		v.syncSegment()
+		var segment = v.segments_[len(v.segments_)-1]
+		segment.remaining_++
+		var entry = &queueEntry_[V]{
+			sequence_: sequence,
+			value_:    value,
+			bytes_:    bytes,
+			segment_:  segment,
+		}
+		v.pending_ = append(v.pending_, entry)
+		v.rollSegment()
+	}
+	v.senders_--
+	v.changed_.Broadcast()
+	v.mutex_.Unlock()
+	return added
+}
+
+func (v *persistentQueue_[V]) RemoveFirst() (
+	first V,
+	ok bool,
+) {
+	// The value is acknowledged immediately since there is no receipt for it.
+	var receipt uint64
+	first, receipt, ok = v.RemoveFirstWithReceipt()
+	if ok {
+		v.Acknowledge(receipt)
+	}
+	return
+}
+
+func (v *persistentQueue_[V]) RemoveAll() {
+	// The removed values are discarded so they are acknowledged immediately.
+	var count = v.claimAvailable()
+	v.mutex_.Lock()
+	for range count {
+		v.acknowledgeEntry(v.removeEntry())
+	}
+	v.mutex_.Unlock()
+}
+
+func (v *persistentQueue_[V]) Drain() Sequential[V] {
+	// The drained values are acknowledged immediately since there are no
+	// receipts for them.
+	var count = v.claimAvailable()
+	var values = ListClass[V]().List()
+	v.mutex_.Lock()
+	for range count {
+		var entry = v.removeEntry()
+		v.acknowledgeEntry(entry)
+		values.AppendValue(entry.value_)
+	}
+	v.mutex_.Unlock()
+	return values
+}
+
+func (v *persistentQueue_[V]) CloseChannel() {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	if v.closed_ {
+		return
+	}
+	v.closed_ = true
+
+	// Any blocked producers must give up before the channel can be closed.
+	close(v.closing_)
+	for v.senders_ > 0 {
+		v.changed_.Wait()
+	}
+	close(v.available_)
+	// No more values can be placed on the queue.
+}
+
+func (v *persistentQueue_[V]) CloseAndWait() {
+	v.CloseChannel()
+	v.mutex_.Lock()
+	for len(v.available_) > 0 || len(v.pending_) > 0 {
+		v.changed_.Wait()
+	}
+	v.mutex_.Unlock()
+}
+
+func (v *persistentQueue_[V]) IsClosed() bool {
+	v.mutex_.Lock()
+	var closed = v.closed_
+	v.mutex_.Unlock()
+	return closed
+}
+
+// Sequential[V] Methods
+
+func (v *persistentQueue_[V]) IsEmpty() bool {
+	v.mutex_.Lock()
+	var result = len(v.pending_) == 0
+	v.mutex_.Unlock()
+	return result
+}
+
+func (v *persistentQueue_[V]) GetSize() uint {
+	v.mutex_.Lock()
+	var size = uti.ArraySize(v.pending_)
+	v.mutex_.Unlock()
+	return size
+}
+
+func (v *persistentQueue_[V]) AsArray() []V {
+	v.mutex_.Lock()
+	var array = make([]V, len(v.pending_))
+	for index, entry := range v.pending_ {
+		array[index] = entry.value_
+	}
+	v.mutex_.Unlock()
+	return array
+}
+
+func (v *persistentQueue_[V]) GetIterator() uti.IteratorLike[V] {
+	var iterator = uti.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *persistentQueue_[V]) String() string {
+	return uti.Format(v.AsArray())
+}
+
+// Private Methods
+
+// This private method records the acknowledgement of the specified entry and
+// deletes any segments that are no longer needed.  Once the queue has been
+// closed the entry remains in the log for the next time it is opened.
+func (v *persistentQueue_[V]) acknowledgeEntry(
+	entry *queueEntry_[V],
+) {
+	if v.released_ {
+		return
+	}
+	v.writeRecord(persistentQueueClass[V]().acknowledgementRecord_, entry.sequence_, nil)
+	v.syncSegment()
+	entry.segment_.remaining_--
+	v.deleteSegments()
+	v.rollSegment()
+}
+
+// This private method claims each value that is currently available without
+// blocking and returns the number of values that were claimed.
+func (v *persistentQueue_[V]) claimAvailable() uint {
+	var count uint
+	for {
+		select {
+		case _, ok := <-v.available_:
+			if !ok {
+				return count
+			}
+			count++
+		default:
+			return count
+		}
+	}
+}
+
+// This private method creates a new segment and makes it the active segment.
+func (v *persistentQueue_[V]) createSegment(
+	number uint64,
+) *queueSegment_ {
+	var class = persistentQueueClass[V]()
+	var path = pat.Join(v.directory_, fmt.Sprintf(class.segmentFormat_, number))
+	var file, err = osx.OpenFile(path, osx.O_CREATE|osx.O_WRONLY|osx.O_APPEND, 0o644)
+	v.checkError(err)
+	var segment = &queueSegment_{
+		path_:   path,
+		number_: number,
+		file_:   file,
+	}
+	v.segments_ = append(v.segments_, segment)
+	return segment
+}
+
+func (v *persistentQueue_[V]) deleteSegment(
+	segment *queueSegment_,
+) {
+	if segment.file_ != nil {
+		v.checkError(segment.file_.Close())
+		segment.file_ = nil
+	}
+	v.checkError(osx.Remove(segment.path_))
+	v.segments_ = sli.DeleteFunc(v.segments_, func(candidate *queueSegment_) bool {
+		return candidate == segment
+	})
+}
+
+// This private method deletes the oldest segments for as long as each of their
+// values has been acknowledged.  A segment must be kept while any older segment
+// remains since it may contain the acknowledgements of values in that segment.
+// The active segment is never deleted.
+func (v *persistentQueue_[V]) deleteSegments() {
+	for len(v.segments_) > 1 && v.segments_[0].remaining_ == 0 {
+		v.deleteSegment(v.segments_[0])
+	}
+}
+
+func (v *persistentQueue_[V]) checkError(
+	err error,
+) {
+	if err != nil {
+		var message = fmt.Sprintf(
+			"Unable to access the persistent queue in %v: %v",
+			v.directory_,
+			err,
+		)
+		panic(message)
+	}
+}
+
+// This private method removes the first pending entry once its availability
+// has been claimed, waiting for its producer to log it if necessary. The mutex
+// must be held by the caller.
+func (v *persistentQueue_[V]) removeEntry() *queueEntry_[V] {
+	for len(v.pending_) == 0 {
+		v.changed_.Wait()
+	}
+	var entry = v.pending_[0]
+	v.pending_ = v.pending_[1:]
+	v.changed_.Broadcast()
+	return entry
+}
+
+// This private method reads each segment in order, discarding any partially
+// written record at the end of a segment, and restores the values that have
+// not been acknowledged.
+func (v *persistentQueue_[V]) replayLog() {
+	var class = persistentQueueClass[V]()
+	var paths, err = pat.Glob(pat.Join(v.directory_, class.segmentPattern_))
+	v.checkError(err)
+	sli.Sort(paths)
+
+	var entries = map[uint64]*queueEntry_[V]{}
+	var segments []*queueSegment_
+	for _, path := range paths {
+		var segment = &queueSegment_{path_: path}
+		_, err = fmt.Sscanf(pat.Base(path), class.segmentFormat_, &segment.number_)
+		v.checkError(err)
+		segments = append(segments, segment)
+		v.replaySegment(segment, entries)
+	}
+
+	// The values are restored in the order that they were added.
+	for _, entry := range entries {
+		entry.segment_.remaining_++
+		v.pending_ = append(v.pending_, entry)
+	}
+	sli.SortFunc(v.pending_, class.compareEntries)
+
+	// The oldest segments containing only acknowledged values are deleted.
+	var number uint64
+	if len(segments) > 0 {
+		number = segments[len(segments)-1].number_ + 1
+	}
+	v.segments_ = segments
+	v.createSegment(number)
+	v.deleteSegments()
+
+	// The capacity must allow each value to be available without blocking.
+	v.capacity_ = max(v.capacity_, uti.ArraySize(v.pending_))
+	v.available_ = make(chan bool, v.capacity_)
+	for range v.pending_ {
+		v.available_ <- true
+	}
+}
+
+func (v *persistentQueue_[V]) replaySegment(
+	segment *queueSegment_,
+	entries map[uint64]*queueEntry_[V],
+) {
+	var class = persistentQueueClass[V]()
+	var bytes, err = osx.ReadFile(segment.path_)
+	v.checkError(err)
+	var offset int
+	for {
+		var kind, sequence, payload, size = class.parseRecord(bytes[offset:])
+		if size == 0 {
+			break
+		}
+		offset += size
+		v.sequence_ = max(v.sequence_, sequence+1)
+		switch kind {
+		case class.valueRecord_:
+			entries[sequence] = &queueEntry_[V]{
+				sequence_: sequence,
+				value_:    v.decoder_(payload),
+				bytes_:    payload,
+				segment_:  segment,
+			}
+		case class.acknowledgementRecord_:
+			delete(entries, sequence)
+		}
+	}
+	if offset < len(bytes) {
+		// Discard the partially written record.
+		v.checkError(osx.Truncate(segment.path_, int64(offset)))
+	}
+	segment.size_ = int64(offset)
+}
+
+// This private method starts a new segment once the active segment is full.
+func (v *persistentQueue_[V]) rollSegment() {
+	var class = persistentQueueClass[V]()
+	var active = v.segments_[len(v.segments_)-1]
+	if active.size_ < class.segmentSize_ {
+		return
+	}
+	v.checkError(active.file_.Close())
+	active.file_ = nil
+	v.createSegment(active.number_ + 1)
+	v.deleteSegments()
+}
+
+func (v *persistentQueue_[V]) syncSegment() {
+	var active = v.segments_[len(v.segments_)-1]
+	v.checkError(active.file_.Sync())
+}
+
+// This private method appends a record to the active segment.  Each record has
+// the form: kind (1 byte), sequence (8 bytes), size (4 bytes), payload (size
+// bytes) and a checksum of the preceding bytes (4 bytes).
+func (v *persistentQueue_[V]) writeRecord(
+	kind byte,
+	sequence uint64,
+	payload []byte,
+) {
+	var record = []byte{kind}
+	record = bin.LittleEndian.AppendUint64(record, sequence)
+	record = bin.LittleEndian.AppendUint32(record, uint32(len(payload)))
+	record = append(record, payload...)
+	record = bin.LittleEndian.AppendUint32(record, crc.ChecksumIEEE(record))
+	var active = v.segments_[len(v.segments_)-1]
+	var _, err = active.file_.Write(record)
+	v.checkError(err)
+	active.size_ += int64(len(record))
+}
+
+func (c *persistentQueueClass_[V]) compareEntries(
+	first *queueEntry_[V],
+	second *queueEntry_[V],
+) int {
+	return cmp.Compare(first.sequence_, second.sequence_)
+}
+
+// This private method returns the parts of the first record in the specified
+// bytes and the size of the record, or a size of zero if the record is partial
+// or corrupt.
+func (c *persistentQueueClass_[V]) parseRecord(
+	bytes []byte,
+) (
+	kind byte,
+	sequence uint64,
+	payload []byte,
+	size int,
+) {
+	var header = 13
+	if len(bytes) < header+4 {
+		return
+	}
+	var length = int(bin.LittleEndian.Uint32(bytes[9:header]))
+	if len(bytes)-header-4 < length {
+		return
+	}
+	var end = header + length
+	if crc.ChecksumIEEE(bytes[:end]) != bin.LittleEndian.Uint32(bytes[end:]) {
+		return
+	}
+	kind = bytes[0]
+	sequence = bin.LittleEndian.Uint64(bytes[1:9])
+	payload = bytes[header:end]
+	size = end + 4
+	return
+}
+
+// Instance Structure
+
+type persistentQueue_[V any] struct {
+	// Declare the instance attributes.
+	directory_      string
+	capacity_       uint
+	encoder_        EncodingFunction[V]
+	decoder_        DecodingFunction[V]
+	lock_           *osx.File
+	available_      chan bool
+	closing_        chan bool
+	closed_         bool
+	released_       bool
+	senders_        int
+	mutex_          syn.Mutex
+	changed_        *syn.Cond
+	sequence_       uint64
+	segments_       []*queueSegment_
+	pending_        []*queueEntry_[V]
+	unacknowledged_ map[uint64]*queueEntry_[V]
+}
+
+// Class Structure
+
+type persistentQueueClass_[V any] struct {
+	// Declare the class constants.
+	lockName_              string
+	segmentFormat_         string
+	segmentPattern_        string
+	segmentSize_           int64
+	valueRecord_           byte
+	acknowledgementRecord_ byte
+}
+
+// Class Reference
+
+var persistentQueueMap_ = map[string]any{}
+var persistentQueueMutex_ syn.Mutex
+
+func persistentQueueClass[V any]() *persistentQueueClass_[V] {
+	// Generate the name of the bound class type.
+	var class *persistentQueueClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	persistentQueueMutex_.Lock()
+	var value = persistentQueueMap_[name]
+	switch actual := value.(type) {
+	case *persistentQueueClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &persistentQueueClass_[V]{
+			// Initialize the class constants.
+			lockName_:              "queue.lock",
+			segmentFormat_:         "segment-%020d.log",
+			segmentPattern_:        "segment-*.log",
+			segmentSize_:           4 << 20,
+			valueRecord_:           1,
+			acknowledgementRecord_: 2,
+		}
+		persistentQueueMap_[name] = class
+	}
+	persistentQueueMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
+
+/*
+NOTE:
+The following are private types used by a persistent queue to keep track of its
+values and the segments of its log.  Each entry retains the encoded bytes of its
+value so that the value can be rewritten during compaction.
+*/
+
+type queueEntry_[V any] struct {
+	sequence_ uint64
+	value_    V
+	bytes_    []byte
+	segment_  *queueSegment_
+}
+
+type queueSegment_ struct {
+	path_      string
+	number_    uint64
+	file_      *osx.File
+	size_      int64
+	remaining_ int
+}
@@ -0,0 +1,51 @@
+//go:build unix
+
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	osx "os"
+	pat "path/filepath"
+	sys "syscall"
+)
+
+// Private Methods
+
+// This private method acquires an exclusive lock on the directory of the queue
+// so that no other queue can use it at the same time.  The lock is released by
+// the operating system if the process fails.
+func (v *persistentQueue_[V]) lockDirectory() {
+	var class = persistentQueueClass[V]()
+	v.checkError(osx.MkdirAll(v.directory_, 0o755))
+	var path = pat.Join(v.directory_, class.lockName_)
+	var file, err = osx.OpenFile(path, osx.O_CREATE|osx.O_RDWR, 0o644)
+	v.checkError(err)
+	err = sys.Flock(int(file.Fd()), sys.LOCK_EX|sys.LOCK_NB)
+	if err != nil {
+		file.Close()
+		var message = fmt.Sprintf(
+			"The persistent queue in %v is already open.",
+			v.directory_,
+		)
+		panic(message)
+	}
+	v.lock_ = file
+}
+
+// This private method releases the lock on the directory of the queue.
+func (v *persistentQueue_[V]) unlockDirectory() {
+	v.checkError(v.lock_.Close())
+	v.lock_ = nil
+}
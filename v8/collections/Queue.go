@@ -13,9 +13,17 @@
 package collections
 
 import (
+	ctx "context"
 	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
 	uti "github.com/craterdog/go-missing-utilities/v8"
+	mat "math"
+	rnd "math/rand/v2"
+	ref "reflect"
+	run "runtime"
 	syn "sync"
+	tim "time"
+	wea "weak"
 )
 
 // CLASS INTERFACE
@@ -39,15 +47,13 @@ func (c *queueClass_[V]) QueueWithCapacity(
 	if capacity < 1 {
 		capacity = 16 // This is the default capacity.
 	}
-	var available = make(chan bool, capacity)
-	var listClass = ListClass[V]()
-	var values = listClass.List()
 	var instance = &queue_[V]{
 		// Initialize the instance attributes.
-		available_: available,
-		capacity_:  capacity,
-		values_:    values,
+		capacity_: capacity,
+		buffer_:   make([]V, min(capacity, 16)), // The buffer grows as needed.
 	}
+	instance.initializeConditions()
+	instance.registerMetrics()
 	return instance
 }
 
@@ -102,7 +108,7 @@ func (c *queueClass_[V]) Fork(
 	group.Go(func() {
 		// Write each value read from the input queue to each output queue.
 		var iterator = outputs.GetIterator()
-		for {
+		for open := true; open; {
 			// Read from the input queue.
 			var value, ok = input.RemoveFirst() // Will block when empty.
 			if !ok {
@@ -113,7 +119,9 @@ func (c *queueClass_[V]) Fork(
 			iterator.ToStart()
 			for iterator.HasNext() {
 				var output = iterator.GetNext()
-				output.AddValue(value) // Will block when full.
+				if !output.AddValue(value) { // Will block when full.
+					open = false // An output queue has been closed.
+				}
 			}
 		}
 
@@ -160,7 +168,9 @@ func (c *queueClass_[V]) Split(
 
 			// Write to the next output queue.
 			var output = iterator.GetNext()
-			output.AddValue(value) // Will block when full.
+			if !output.AddValue(value) { // Will block when full.
+				break // The output queue has been closed.
+			}
 			if !iterator.HasNext() {
 				iterator.ToStart()
 			}
@@ -201,7 +211,9 @@ func (c *queueClass_[V]) Join(
 			if !ok {
 				break // The input queue has been closed.
 			}
-			output.AddValue(value) // Will block when full.
+			if !output.AddValue(value) { // Will block when full.
+				break // The output queue has been closed.
+			}
 			if !iterator.HasNext() {
 				iterator.ToStart()
 			}
@@ -214,6 +226,154 @@ func (c *queueClass_[V]) Join(
 	return output
 }
 
+func (c *queueClass_[V]) Throttle(
+	group Synchronized,
+	input QueueLike[V],
+	rate float64,
+	burst uint,
+) QueueLike[V] {
+	return c.ThrottleWithClock(group, input, rate, burst, systemClock_{})
+}
+
+func (c *queueClass_[V]) ThrottleWithClock(
+	group Synchronized,
+	input QueueLike[V],
+	rate float64,
+	burst uint,
+	clock Clock,
+) QueueLike[V] {
+	// Validate the arguments.
+	if !(rate > 0) || mat.IsInf(rate, 1) {
+		panic("The rate for a throttle must be a finite number greater than zero.")
+	}
+	if uti.IsUndefined(clock) {
+		panic("The \"clock\" attribute is required by this class.")
+	}
+	if burst < 1 {
+		burst = 1 // At least one value must be released at a time.
+	}
+
+	// Create the new output queue.
+	var capacity = input.GetCapacity()
+	var output = c.QueueWithCapacity(capacity)
+
+	// Connect up the input queue to the output queue.
+	group.Go(func() {
+		// The token bucket starts out full.
+		var tokens = float64(burst)
+		var last = clock.Now()
+		for {
+			// Read from the input queue.
+			var value, ok = input.RemoveFirst() // Will block when empty.
+			if !ok {
+				break // The input queue has been closed.
+			}
+
+			// Wait for a token to become available.
+			for {
+				var now = clock.Now()
+				tokens = min(float64(burst), tokens+now.Sub(last).Seconds()*rate)
+				last = now
+				if tokens >= 1 {
+					break
+				}
+				var seconds = (1 - tokens) / rate
+				clock.Sleep(tim.Duration(mat.Ceil(seconds * float64(tim.Second))))
+			}
+			tokens--
+
+			// Write to the output queue.
+			if !output.AddValue(value) { // Will block when full.
+				break // The output queue has been closed.
+			}
+		}
+
+		// Close the output queue.
+		output.CloseChannel()
+	})
+
+	return output
+}
+
+func (c *queueClass_[V]) Select(
+	queues Sequential[QueueLike[V]],
+) (
+	index int,
+	value V,
+	ok bool,
+) {
+	return c.SelectWithContext(ctx.Background(), queues)
+}
+
+func (c *queueClass_[V]) SelectWithContext(
+	context ctx.Context,
+	queues Sequential[QueueLike[V]],
+) (
+	index int,
+	value V,
+	ok bool,
+) {
+	// Validate the arguments.
+	if context == nil {
+		panic("The \"context\" attribute is required by this class.")
+	}
+	if !uti.IsDefined(queues) || queues.IsEmpty() {
+		panic("The number of queues for a select must be at least one.")
+	}
+	var inputs []*queue_[V]
+	for _, queue := range queues.AsArray() {
+		var input, isQueue = queue.(*queue_[V])
+		if !isQueue {
+			panic("Only queues created by this class can be selected.")
+		}
+		inputs = append(inputs, input)
+	}
+
+	// Remove a value from one of the queues, starting the search at a random
+	// queue so that no queue is starved.
+	index = -1
+	for {
+		// The first case waits for the context to be done.
+		var cases = []ref.SelectCase{
+			{Dir: ref.SelectRecv, Chan: ref.ValueOf(context.Done())},
+		}
+		var waiting []*queue_[V]
+		var offset = rnd.IntN(len(inputs))
+		for count := range inputs {
+			var position = (offset + count) % len(inputs)
+			var input = inputs[position]
+			input.mutex_.Lock()
+			switch {
+			case input.size_ > 0:
+				index = position
+				value = input.removeValue()
+				ok = true
+				input.mutex_.Unlock()
+				return
+			case !input.closed_:
+				// Wait for the queue to change.
+				cases = append(cases, ref.SelectCase{
+					Dir:  ref.SelectRecv,
+					Chan: ref.ValueOf(input.watchChanges()),
+				})
+				waiting = append(waiting, input)
+			}
+			input.mutex_.Unlock()
+		}
+
+		// Stop once the context is done or every queue is closed and empty.
+		if len(cases) == 1 {
+			return
+		}
+		c.adjustConsumers(waiting, 1)
+		var chosen, _, _ = ref.Select(cases)
+		c.adjustConsumers(waiting, -1)
+		if chosen == 0 {
+			return
+		}
+	}
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -232,11 +392,32 @@ func (v *queue_[V]) GetCapacity() uint {
 
 func (v *queue_[V]) AddValue(
 	value V,
-) {
+) bool {
 	v.mutex_.Lock()
-	v.values_.AppendValue(value)
-	v.mutex_.Unlock()
-	v.available_ <- true // The queue will block if at capacity.
+	defer v.mutex_.Unlock()
+	if v.size_ == v.capacity_ && !v.closed_ {
+		v.accrueBlocked()
+		v.producersWaiting_++
+		for v.size_ == v.capacity_ && !v.closed_ {
+			v.notFull_.Wait() // The queue will block if at capacity.
+		}
+		v.accrueBlocked()
+		v.producersWaiting_--
+	}
+	if v.closed_ {
+		return false
+	}
+	if v.size_ == uti.ArraySize(v.buffer_) {
+		v.growBuffer()
+	}
+	var slot = (v.head_ + v.size_) % uti.ArraySize(v.buffer_)
+	v.buffer_[slot] = value
+	v.size_++
+	v.enqueued_++
+	v.highWaterMark_ = max(v.highWaterMark_, v.size_)
+	v.notEmpty_.Signal()
+	v.notifyWatchers()
+	return true
 }
 
 func (v *queue_[V]) RemoveFirst() (
@@ -244,27 +425,154 @@ func (v *queue_[V]) RemoveFirst() (
 	ok bool,
 ) {
 	// Remove the first value from the queue if one exists.
-	_, ok = <-v.available_ // Will block until a value is available.
-	if ok {
-		v.mutex_.Lock()
-		first = v.values_.RemoveValue(1)
-		v.mutex_.Unlock()
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	if v.size_ == 0 && !v.closed_ {
+		v.accrueBlocked()
+		v.consumersWaiting_++
+		for v.size_ == 0 && !v.closed_ {
+			v.notEmpty_.Wait() // Will block until a value is available.
+		}
+		v.accrueBlocked()
+		v.consumersWaiting_--
+	}
+	if v.size_ > 0 {
+		first = v.removeValue()
+		ok = true
 	}
 	return
 }
 
 func (v *queue_[V]) RemoveAll() {
 	v.mutex_.Lock()
-	v.available_ = make(chan bool, v.capacity_)
-	var listClass = ListClass[V]()
-	v.values_ = listClass.List()
+	for v.size_ > 0 {
+		v.removeValue()
+	}
+	v.mutex_.Unlock()
+}
+
+func (v *queue_[V]) Drain() Sequential[V] {
+	var values = ListClass[V]().List()
+	v.mutex_.Lock()
+	for v.size_ > 0 {
+		values.AppendValue(v.removeValue())
+	}
 	v.mutex_.Unlock()
+	return values
 }
 
 func (v *queue_[V]) CloseChannel() {
 	v.mutex_.Lock()
-	close(v.available_)
-	// No more values can be placed on the queue.
+	if !v.closed_ {
+		// No more values can be placed on the queue.
+		v.closed_ = true
+		v.notEmpty_.Broadcast()
+		v.notFull_.Broadcast()
+		v.notifyWatchers()
+	}
+	v.mutex_.Unlock()
+}
+
+func (v *queue_[V]) CloseAndWait() {
+	v.CloseChannel()
+	v.mutex_.Lock()
+	for v.size_ > 0 {
+		v.notFull_.Wait()
+	}
+	v.mutex_.Unlock()
+}
+
+func (v *queue_[V]) IsClosed() bool {
+	v.mutex_.Lock()
+	var closed = v.closed_
+	v.mutex_.Unlock()
+	return closed
+}
+
+// Measurable Methods
+
+func (v *queue_[V]) GetName() string {
+	v.mutex_.Lock()
+	var name = v.name_
+	v.mutex_.Unlock()
+	return name
+}
+
+func (v *queue_[V]) SetName(
+	name string,
+) {
+	v.mutex_.Lock()
+	v.name_ = name
+	v.mutex_.Unlock()
+}
+
+func (v *queue_[V]) GetMetrics() MetricsLike {
+	v.mutex_.Lock()
+	v.accrueBlocked() // Include the time that is still being spent blocked.
+	var metrics = metricsClass().Metrics(
+		v.name_,
+		v.capacity_,
+		v.enqueued_,
+		v.dequeued_,
+		v.size_,
+		v.highWaterMark_,
+		v.producerBlocked_,
+		v.consumerBlocked_,
+	)
+	v.mutex_.Unlock()
+	return metrics
+}
+
+// Serializable Methods
+
+func (v *queue_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	v.mutex_.Lock()
+	var capacity = v.capacity_
+	var array = v.asArray()
+	v.mutex_.Unlock()
+	encoder.EncodeValue(capacity)
+	encoder.EncodeValue(uti.ArraySize(array))
+	for _, value := range array {
+		encoder.EncodeValue(value)
+	}
+}
+
+func (v *queue_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var capacity, size uint
+	if decoder.DecodeValue(&capacity) != nil || decoder.DecodeValue(&size) != nil {
+		return
+	}
+
+	var buffer, decoded = listClass[V]().decodeArray(decoder, size)
+	if !decoded {
+		return
+	}
+
+	// The capacity must allow each value to be added without blocking.
+	capacity = max(capacity, size, 1)
+	buffer = buffer[:min(uint(cap(buffer)), capacity)]
+	v.mutex_.Lock()
+	if v.notEmpty_ == nil {
+		// The queue was created by a decoder.
+		v.initializeConditions()
+	}
+	v.registerMetrics() // Only the first call registers the queue.
+	v.capacity_ = capacity
+	v.buffer_ = buffer
+	v.head_ = 0
+
+	// The replaced values count as dequeued and the decoded values as enqueued.
+	v.dequeued_ += uint64(v.size_)
+	v.enqueued_ += uint64(size)
+	v.highWaterMark_ = max(v.highWaterMark_, size)
+	v.size_ = size
+	v.closed_ = false
+	v.notFull_.Broadcast()
+	v.notifyWatchers()
 	v.mutex_.Unlock()
 }
 
@@ -272,29 +580,28 @@ func (v *queue_[V]) CloseChannel() {
 
 func (v *queue_[V]) IsEmpty() bool {
 	v.mutex_.Lock()
-	var result = len(v.available_) == 0
+	var result = v.size_ == 0
 	v.mutex_.Unlock()
 	return result
 }
 
 func (v *queue_[V]) GetSize() uint {
 	v.mutex_.Lock()
-	var size = uint(len(v.available_))
+	var size = v.size_
 	v.mutex_.Unlock()
 	return size
 }
 
 func (v *queue_[V]) AsArray() []V {
 	v.mutex_.Lock()
-	var array = v.values_.AsArray()
+	var array = v.asArray()
 	v.mutex_.Unlock()
 	return array
 }
 
 func (v *queue_[V]) GetIterator() uti.IteratorLike[V] {
-	v.mutex_.Lock()
-	var iterator = v.values_.GetIterator()
-	v.mutex_.Unlock()
+	var array = v.AsArray()
+	var iterator = uti.Iterator(array)
 	return iterator
 }
 
@@ -306,19 +613,144 @@ func (v *queue_[V]) String() string {
 
 // Private Methods
 
+// This private class method adds the specified change to the number of consumers
+// that are waiting on each of the specified queues.
+func (c *queueClass_[V]) adjustConsumers(
+	queues []*queue_[V],
+	change int,
+) {
+	for _, queue := range queues {
+		queue.mutex_.Lock()
+		queue.accrueBlocked()
+		queue.consumersWaiting_ += change
+		queue.mutex_.Unlock()
+	}
+}
+
+// This private method adds the time that each waiting producer and consumer has
+// spent blocked since the last time it was called to the blocked durations.  The
+// mutex must be held by the caller.
+func (v *queue_[V]) accrueBlocked() {
+	var now = tim.Now()
+	if v.producersWaiting_ > 0 || v.consumersWaiting_ > 0 {
+		var elapsed = now.Sub(v.accrued_)
+		v.producerBlocked_ += tim.Duration(v.producersWaiting_) * elapsed
+		v.consumerBlocked_ += tim.Duration(v.consumersWaiting_) * elapsed
+	}
+	v.accrued_ = now
+}
+
+// This private method returns the values in the queue in order.  The mutex must
+// be held by the caller.
+func (v *queue_[V]) asArray() []V {
+	var array = make([]V, v.size_)
+	for index := range array {
+		array[index] = v.buffer_[(v.head_+uint(index))%uti.ArraySize(v.buffer_)]
+	}
+	return array
+}
+
+// This private method doubles the size of the ring buffer without exceeding the
+// capacity of the queue.  The mutex must be held by the caller.
+func (v *queue_[V]) growBuffer() {
+	var size = min(max(2*uti.ArraySize(v.buffer_), 16), v.capacity_)
+	var buffer = make([]V, size)
+	copy(buffer, v.asArray())
+	v.buffer_ = buffer
+	v.head_ = 0
+}
+
+// This private method creates the condition variables that share the mutex.
+func (v *queue_[V]) initializeConditions() {
+	v.notEmpty_ = syn.NewCond(&v.mutex_)
+	v.notFull_ = syn.NewCond(&v.mutex_)
+}
+
+// This private method wakes any selections that are waiting for the queue to
+// change.  The mutex must be held by the caller.
+func (v *queue_[V]) notifyWatchers() {
+	if v.changed_ != nil {
+		close(v.changed_)
+		v.changed_ = nil
+	}
+}
+
+// This private method adds the queue to the metrics registry.  The queue is
+// removed from the registry once it is no longer referenced elsewhere.
+func (v *queue_[V]) registerMetrics() {
+	if v.registered_ {
+		// The queue is already in the registry.
+		return
+	}
+	v.registered_ = true
+	var class = metricsClass()
+	var pointer = wea.Make(v)
+	var key = class.register(func() Measurable {
+		var queue = pointer.Value()
+		if queue == nil {
+			return nil
+		}
+		return queue
+	})
+	run.AddCleanup(v, class.unregister, key)
+}
+
+// This private method removes the first value from a queue that is not empty.
+// The mutex must be held by the caller.
+func (v *queue_[V]) removeValue() V {
+	var first = v.buffer_[v.head_]
+	var zero V
+	v.buffer_[v.head_] = zero // Release the value for garbage collection.
+	v.head_ = (v.head_ + 1) % uti.ArraySize(v.buffer_)
+	v.size_--
+	v.dequeued_++
+	if v.size_ == 0 {
+		v.notFull_.Broadcast() // Wake anyone waiting for the queue to empty.
+	} else {
+		v.notFull_.Signal()
+	}
+	return first
+}
+
+// This private method returns a channel that is closed the next time a value
+// is added to the queue or the queue is closed.  The mutex must be held by the
+// caller.
+func (v *queue_[V]) watchChanges() chan bool {
+	if v.changed_ == nil {
+		v.changed_ = make(chan bool)
+	}
+	return v.changed_
+}
+
 // Instance Structure
 
 // NOTE:
-// If the Go "chan" type ever supports snapshots of its state, the underlying
-// list and mutex can be removed and the channel modified to pass the values
-// instead of the availability. Currently, the underlying list is only required
-// by the "AsArray()" instance method.
+// The values are held in a ring buffer that is protected by the mutex so that
+// each snapshot of the queue is consistent with the values that have been added
+// and removed.  The ring buffer grows as needed up to the capacity of the queue.
+// Blocked producers and consumers wait on the condition variables while
+// selections wait on the change channel.
 type queue_[V any] struct {
 	// Declare the instance attributes.
-	available_ chan bool
-	capacity_  uint
-	mutex_     syn.Mutex
-	values_    ListLike[V]
+	capacity_         uint
+	buffer_           []V
+	head_             uint
+	size_             uint
+	closed_           bool
+	mutex_            syn.Mutex
+	notEmpty_         *syn.Cond
+	notFull_          *syn.Cond
+	changed_          chan bool
+	name_             string
+	enqueued_         uint64
+	dequeued_         uint64
+	highWaterMark_    uint
+	producerBlocked_  tim.Duration
+	consumerBlocked_  tim.Duration
+	producersWaiting_ int
+	consumersWaiting_ int
+	accrued_          tim.Time
+	registered_       bool
 }
 
 // Class Structure
@@ -350,9 +782,30 @@ func queueClass[V any]() *queueClass_[V] {
 			// Initialize the class constants.
 		}
 		queueMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&queue_[V]{})
 	}
 	queueMutex_.Unlock()
 
 	// Return a reference to the bound class type.
 	return class
 }
+
+/*
+NOTE:
+The following is a private implementation of the clock that is used by the
+Throttle() class function.  It simply delegates to the "time" package.
+*/
+
+type systemClock_ struct{}
+
+func (v systemClock_) Now() tim.Time {
+	return tim.Now()
+}
+
+func (v systemClock_) Sleep(
+	duration tim.Duration,
+) {
+	tim.Sleep(duration)
+}
@@ -42,7 +42,7 @@ func (c *setClass_[V]) SetWithCollator(
 		panic("The \"collator\" attribute is required by this class.")
 	}
 	var listClass = ListClass[V]()
-	var values = listClass.List()
+	var values = listClass.ListWithCollator(collator)
 	var instance = &set_[V]{
 		// Initialize the instance attributes.
 		collator_: collator,
@@ -122,6 +122,18 @@ func (c *setClass_[V]) Xor(
 	return c.Ior(c.San(first, second), c.San(second, first))
 }
 
+func (c *setClass_[V]) ReadOnly(
+	set SetLike[V],
+) SetViewLike[V] {
+	if uti.IsUndefined(set) {
+		panic("The \"set\" attribute is required by this class.")
+	}
+	var view = &setView_[V]{
+		set_: set,
+	}
+	return view
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -172,17 +184,21 @@ func (v *set_[V]) AddValue(
 	if !found {
 		// The value is not already a member, so add it.
 		v.values_.InsertValue(uint(slot), value)
+		var index = slot + 1
+		v.observers_.notify(Inserted, index, index, []V{value}, nil)
 	}
 }
 
 func (v *set_[V]) AddValues(
 	values Sequential[V],
 ) {
-	var iterator = values.GetIterator()
-	for iterator.HasNext() {
-		var value = iterator.GetNext()
-		v.AddValue(value)
-	}
+	v.observers_.batch(func() {
+		var iterator = values.GetIterator()
+		for iterator.HasNext() {
+			var value = iterator.GetNext()
+			v.AddValue(value)
+		}
+	})
 }
 
 func (v *set_[V]) RemoveValue(
@@ -191,24 +207,46 @@ func (v *set_[V]) RemoveValue(
 	var index, found = v.findIndex(value)
 	if found {
 		// The value is a member, so remove it.
-		v.values_.RemoveValue(index)
+		var removed = v.values_.RemoveValue(index)
+		v.observers_.notify(Removed, index, index, nil, []V{removed})
 	}
 }
 
 func (v *set_[V]) RemoveValues(
 	values Sequential[V],
 ) {
-	var iterator = values.GetIterator()
-	for iterator.HasNext() {
-		var value = iterator.GetNext()
-		v.RemoveValue(value)
-	}
+	v.observers_.batch(func() {
+		var iterator = values.GetIterator()
+		for iterator.HasNext() {
+			var value = iterator.GetNext()
+			v.RemoveValue(value)
+		}
+	})
 }
 
 func (v *set_[V]) RemoveAll() {
+	var size = int(v.values_.GetSize())
+	if size > 0 && v.observers_.isObserved() {
+		var removed = v.values_.AsArray()
+		v.observers_.notify(Removed, 1, size, nil, removed)
+	}
 	v.values_.RemoveAll()
 }
 
+// Observable[V] Methods
+
+func (v *set_[V]) Subscribe(
+	listener ListenerFunction[V],
+) Subscription {
+	return v.observers_.subscribe(listener)
+}
+
+func (v *set_[V]) Batch(
+	function func(),
+) {
+	v.observers_.batch(function)
+}
+
 // Searchable[V] Methods
 
 func (v *set_[V]) ContainsValue(
@@ -248,6 +286,50 @@ func (v *set_[V]) ContainsAll(
 	return true
 }
 
+// Serializable Methods
+
+func (v *set_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeCollator(v.collator_)
+	encoder.EncodeValue(v.values_.GetSize())
+	var iterator = v.values_.GetIterator()
+	for iterator.HasNext() {
+		encoder.EncodeValue(iterator.GetNext())
+	}
+}
+
+func (v *set_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var collator, ok = decoder.DecodeCollator().(age.CollatorLike[V])
+	if !ok {
+		collator = age.CollatorClass[V]().Collator()
+	}
+	var size uint
+	if decoder.DecodeValue(&size) != nil {
+		return
+	}
+	var array, decoded = listClass[V]().decodeArray(decoder, size)
+	if !decoded {
+		return
+	}
+
+	// The values are sorted again in case the collator has changed.
+	var values = &list_[V]{
+		collator_: collator,
+		array_:    array,
+	}
+	for index := 1; index < len(array); index++ {
+		if collator.RankValues(array[index-1], array[index]) == age.GreaterRank {
+			values.SortValues()
+			break
+		}
+	}
+	v.collator_ = collator
+	v.values_ = values
+}
+
 // Sequential[V] Methods
 
 func (v *set_[V]) IsEmpty() bool {
@@ -285,45 +367,16 @@ func (v *set_[V]) String() string {
 //
 // The algorithm performs a true O[log(n)] worst case search.
 func (v *set_[V]) findIndex(value V) (index int, found bool) {
-	// We use iteration instead of recursion for better performance.
-	//    start        first      middle       last          end
-	//    |-------------||----------||----------||-------------|
-	//                  |<-- size -------------->|
-	//
-	var first = 1               // Start at the beginning.
-	var last = int(v.GetSize()) // End at the end.
-	var size = last             // Initially all values are candidates.
-	for size > 0 {
-		var middle = first + size/2 // Rounds down to the nearest integer.
-		var candidate = v.GetValue(int(middle))
-		switch v.collator_.RankValues(value, candidate) {
-		case age.LesserRank:
-			// The index of the value is less than the middle
-			// index so the first index stays the same.
-			last = middle - 1 // We already tried the middle index.
-			size = middle - first
-		case age.EqualRank:
-			// The index of the value is the middle index.
-			return middle, true
-		case age.GreaterRank:
-			// The index of the value is greater than the middle
-			// index so the last index stays the same.
-			first = middle + 1 // We already tried the middle index.
-			size = last - middle
-		}
-	}
-	// The value was not found, the last index represents the SLOT where it
-	// would be inserted.  Since the value was not found, the indexes are
-	// inverted: last < first (i.e. last = first - 1).
-	return last, false
+	return v.values_.SearchValue(value, v.collator_.RankValues)
 }
 
 // Instance Structure
 
 type set_[V any] struct {
 	// Declare the instance attributes.
-	collator_ age.CollatorLike[V]
-	values_   ListLike[V]
+	collator_  age.CollatorLike[V]
+	values_    ListLike[V]
+	observers_ observers_[V]
 }
 
 // Class Structure
@@ -355,9 +408,91 @@ func setClass[V any]() *setClass_[V] {
 			// Initialize the class constants.
 		}
 		setMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&set_[V]{})
 	}
 	setMutex_.Unlock()
 
 	// Return a reference to the bound class type.
 	return class
 }
+
+/*
+NOTE:
+The following is a private implementation of a read-only view of a set.  It
+delegates each query to the set itself so that it always reflects the current
+state of the set.  Since the set is never exposed by the view, it cannot be
+changed through the view.
+*/
+
+type setView_[V any] struct {
+	set_ SetLike[V]
+}
+
+func (v *setView_[V]) AsSet() SetLike[V] {
+	var set = setClass[V]().SetWithCollator(v.set_.GetCollator())
+	set.AddValues(v.set_)
+	return set
+}
+
+func (v *setView_[V]) GetCollator() age.CollatorLike[V] {
+	return v.set_.GetCollator()
+}
+
+func (v *setView_[V]) GetValue(
+	index int,
+) V {
+	return v.set_.GetValue(index)
+}
+
+func (v *setView_[V]) GetValues(
+	first int,
+	last int,
+) Sequential[V] {
+	return v.set_.GetValues(first, last)
+}
+
+func (v *setView_[V]) GetIndex(
+	value V,
+) int {
+	return v.set_.GetIndex(value)
+}
+
+func (v *setView_[V]) ContainsValue(
+	value V,
+) bool {
+	return v.set_.ContainsValue(value)
+}
+
+func (v *setView_[V]) ContainsAny(
+	values Sequential[V],
+) bool {
+	return v.set_.ContainsAny(values)
+}
+
+func (v *setView_[V]) ContainsAll(
+	values Sequential[V],
+) bool {
+	return v.set_.ContainsAll(values)
+}
+
+func (v *setView_[V]) IsEmpty() bool {
+	return v.set_.IsEmpty()
+}
+
+func (v *setView_[V]) GetSize() uint {
+	return v.set_.GetSize()
+}
+
+func (v *setView_[V]) AsArray() []V {
+	return v.set_.AsArray()
+}
+
+func (v *setView_[V]) GetIterator() uti.IteratorLike[V] {
+	return v.set_.GetIterator()
+}
+
+func (v *setView_[V]) String() string {
+	return uti.Format(v)
+}
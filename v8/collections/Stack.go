@@ -14,6 +14,7 @@ package collections
 
 import (
 	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
 	uti "github.com/craterdog/go-missing-utilities/v8"
 	syn "sync"
 )
@@ -130,6 +131,37 @@ func (v *stack_[V]) RemoveAll() {
 	v.values_.RemoveAll()
 }
 
+// Serializable Methods
+
+func (v *stack_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeValue(v.capacity_)
+	encoder.EncodeValue(v.values_.GetSize())
+	var iterator = v.values_.GetIterator()
+	for iterator.HasNext() {
+		encoder.EncodeValue(iterator.GetNext())
+	}
+}
+
+func (v *stack_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var capacity, size uint
+	if decoder.DecodeValue(&capacity) != nil || decoder.DecodeValue(&size) != nil {
+		return
+	}
+	var array, decoded = listClass[V]().decodeArray(decoder, size)
+	if !decoded {
+		return
+	}
+	v.capacity_ = max(capacity, size, 1)
+	v.values_ = &list_[V]{
+		collator_: age.CollatorClass[V]().Collator(),
+		array_:    array,
+	}
+}
+
 // Sequential[V] Methods
 
 func (v *stack_[V]) IsEmpty() bool {
@@ -198,6 +230,9 @@ func stackClass[V any]() *stackClass_[V] {
 			defaultCapacity_: 16,
 		}
 		stackMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&stack_[V]{})
 	}
 	stackMutex_.Unlock()
 
@@ -15,10 +15,22 @@ Package "collections" declares a set of collection classes that maintain values
 of a generic type:
   - Catalog (a sortable map of key-value associations)
   - List (a sortable list)
+  - PersistentQueue (a blocking FIFO that is stored on disk)
   - Queue (a blocking FIFO)
   - Set (an ordered set)
   - Stack (a LIFO)
 
+It also declares persistent (immutable) variants of some of these classes whose
+instances can never change:
+  - ImmutableCatalog (an immutable map of key-value associations)
+  - ImmutableList (an immutable list)
+  - ImmutableSet (an immutable ordered set)
+
+The mutable collections (other than queues and stacks) are also observable, and
+each change made to one of them is described by an instance of the Event class.
+Each queue is measurable, and a snapshot of its activity is described by an
+instance of the Metrics class.
+
 For detailed documentation on this package refer to the wiki:
   - https://github.com/craterdog/go-collection-framework/wiki
 
@@ -34,14 +46,60 @@ on interfaces, not on each other.
 package collections
 
 import (
+	ctx "context"
+	fmt "fmt"
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	uti "github.com/craterdog/go-missing-utilities/v8"
+	rnd "math/rand/v2"
+	tim "time"
 )
 
 // TYPE DECLARATIONS
 
+/*
+Change is a constrained type representing the kinds of changes that can be made
+to an observable collection.
+*/
+type Change uint8
+
+const (
+	Inserted Change = iota
+	Removed
+	Replaced
+	Reordered
+	KeyAdded
+	KeyUpdated
+	KeyRemoved
+)
+
 // FUNCTIONAL DECLARATIONS
 
+/*
+DecodingFunction[V any] is a functional type that declares the signature for
+any function that can decode a value from the bytes that were produced by the
+corresponding encoding function.
+*/
+type DecodingFunction[V any] func(
+	bytes []byte,
+) V
+
+/*
+EncodingFunction[V any] is a functional type that declares the signature for
+any function that can encode a value as bytes.
+*/
+type EncodingFunction[V any] func(
+	value V,
+) []byte
+
+/*
+ListenerFunction[V any] is a functional type that declares the signature for
+any function that can be notified of the changes made to an observable
+collection.  The events are in the order that the changes were made.
+*/
+type ListenerFunction[V any] func(
+	events Sequential[EventLike[V]],
+)
+
 // CLASS DECLARATIONS
 
 /*
@@ -51,6 +109,14 @@ supported by each concrete association-like class.
 
 An association-like class captures the relationship between a generic typed
 key-value pair.
+
+The following class functions are also supported:
+
+RankByKey() returns a ranking function that ranks two associations by ranking
+their keys using the specified ranking function.
+
+RankByValue() returns a ranking function that ranks two associations by ranking
+their values using the specified ranking function.
 */
 type AssociationClassLike[K comparable, V any] interface {
 	// Constructor Methods
@@ -58,6 +124,14 @@ type AssociationClassLike[K comparable, V any] interface {
 		key K,
 		value V,
 	) AssociationLike[K, V]
+
+	// Function Methods
+	RankByKey(
+		ranker age.RankingFunction[K],
+	) age.RankingFunction[AssociationLike[K, V]]
+	RankByValue(
+		ranker age.RankingFunction[V],
+	) age.RankingFunction[AssociationLike[K, V]]
 }
 
 /*
@@ -69,7 +143,9 @@ A catalog-like class maintains a sortable set of generic typed key-value
 associations.  Unlike the intrinsic Go map data type, the order of the
 associations in a catalog is the order in which they were added to the catalog.
 A catalog can also be sorted using either the default "natural" ordering of the
-keys or using a custom association ranking function.
+keys or using a custom association ranking function.  Getting, setting and
+removing the value of a key each take O[1] amortized time, and a key that is
+removed and then set again is moved to the end of the catalog.
 
 The following class functions are also supported:
 
@@ -81,6 +157,11 @@ Merge() returns a new catalog containing all of the associations that are in
 the specified Catalogs in the order that they appear in each catalog.  If a
 key is present in both Catalogs, the value of the key from the second
 catalog takes precedence.
+
+ReadOnly() returns a read-only view of the specified catalog.  The view does not
+copy the catalog, so any changes made to the catalog are visible through the
+view, but the catalog cannot be changed through the view.  The associations
+retrieved through the view are copies of those in the catalog.
 */
 type CatalogClassLike[K comparable, V any] interface {
 	// Constructor Methods
@@ -104,6 +185,131 @@ type CatalogClassLike[K comparable, V any] interface {
 		first CatalogLike[K, V],
 		second CatalogLike[K, V],
 	) CatalogLike[K, V]
+	ReadOnly(
+		catalog CatalogLike[K, V],
+	) CatalogViewLike[K, V]
+}
+
+/*
+EventClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete event-like class.
+
+An event-like class describes a single change made to an observable collection.
+The first and last indices are ordinal and identify the range of values that
+were affected by the change:
+  - Inserted: the values were inserted at the indices.
+  - Removed: the previous values were removed from the indices.
+  - Replaced: the previous values at the indices were replaced by the values.
+  - Reordered: the values at the indices were rearranged.
+  - KeyAdded: the association (the value) was added for a new key.
+  - KeyUpdated: the association for a key (the previous value) was updated to
+    the association (the value).
+  - KeyRemoved: the association for a key (the previous value) was removed.
+
+The indices of the changes made to the keys of a catalog are both zero since a
+catalog locates its associations by key.
+*/
+type EventClassLike[V any] interface {
+	// Constructor Methods
+	Event(
+		change Change,
+		first int,
+		last int,
+		values Sequential[V],
+		previous Sequential[V],
+	) EventLike[V]
+}
+
+/*
+ImmutableCatalogClassLike[K comparable, V any] is a class interface that
+declares the complete set of class constructors, constants and functions that
+must be supported by each concrete immutable-catalog-like class.
+
+An immutable-catalog-like class maintains a persistent set of generic typed
+key-value associations whose order is the order in which they were added.  Its
+instances never change.  Instead, each method that would otherwise change an
+immutable catalog returns a new immutable catalog that shares most of its
+structure with the original one.  The keys are maintained in a hash array mapped
+trie (HAMT) so that getting, setting or removing the value of a key takes
+O[log(n)] time.
+
+Since associations are mutable, the associations returned by an immutable
+catalog are copies of its own.
+*/
+type ImmutableCatalogClassLike[K comparable, V any] interface {
+	// Constructor Methods
+	ImmutableCatalog() ImmutableCatalogLike[K, V]
+	ImmutableCatalogFromMap(
+		associations map[K]V,
+	) ImmutableCatalogLike[K, V]
+	ImmutableCatalogFromSequence(
+		associations Sequential[AssociationLike[K, V]],
+	) ImmutableCatalogLike[K, V]
+}
+
+/*
+ImmutableListClassLike[V any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete immutable-list-like class.
+
+An immutable-list-like class maintains a persistent sequence of values.  Its
+instances never change.  Instead, each method that would otherwise change an
+immutable list returns a new immutable list that shares most of its structure
+with the original one.  The values are maintained in a 32-way trie along with a
+tail so that accessing or replacing a value takes O[log32(n)] time and appending
+a value or removing the last value takes O[1] amortized time.  Inserting or
+removing a value at any other slot takes O[log32(n)] time to share the values
+before it plus time proportional to the number of values that follow it, since
+they must be shifted.  The values in an immutable list are compared using a
+configurable collator agent.  An immutable list that is created from a sequence
+having its own collator (e.g. a list) uses that collator.
+*/
+type ImmutableListClassLike[V any] interface {
+	// Constructor Methods
+	ImmutableList() ImmutableListLike[V]
+	ImmutableListWithCollator(
+		collator age.CollatorLike[V],
+	) ImmutableListLike[V]
+	ImmutableListFromArray(
+		values []V,
+	) ImmutableListLike[V]
+	ImmutableListFromSequence(
+		values Sequential[V],
+	) ImmutableListLike[V]
+}
+
+/*
+ImmutableSetClassLike[V any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete immutable-set-like class.
+
+An immutable-set-like class maintains a persistent ordered sequence of unique
+values.  The order of the values is determined by a configurable collator
+agent.  Its instances never change.  Instead, each method that would otherwise
+change an immutable set returns a new immutable set that shares most of its
+structure with the original one.  The values are maintained in a balanced
+binary tree so that adding, removing, finding or accessing a value by its index
+takes O[log(n)] time.  An immutable set that is created from a sequence having
+its own collator (e.g. a set) uses that collator unless another one is
+specified.
+*/
+type ImmutableSetClassLike[V any] interface {
+	// Constructor Methods
+	ImmutableSet() ImmutableSetLike[V]
+	ImmutableSetWithCollator(
+		collator age.CollatorLike[V],
+	) ImmutableSetLike[V]
+	ImmutableSetFromArray(
+		values []V,
+	) ImmutableSetLike[V]
+	ImmutableSetFromSequence(
+		values Sequential[V],
+	) ImmutableSetLike[V]
+	ImmutableSetFromSequenceWithCollator(
+		values Sequential[V],
+		collator age.CollatorLike[V],
+	) ImmutableSetLike[V]
 }
 
 /*
@@ -117,14 +323,27 @@ index.  It uses ORDINAL based indexing rather than the more common—and
 nonsensical—ZERO based indexing scheme (see the description of what
 this means in the Accessible[V] interface definition).
 
+The values in a list are compared while searching it, and ranked while sorting
+it by default, using a configurable collator agent.  A custom collator may be
+used to compare the values more efficiently (e.g. by identity or by an
+identifying attribute).
+
 The following class functions are supported:
 
 Concatenate() combines two lists into a new list containing all values in both
-lists.  The order of the values in each list is preserved in the new list.
+lists.  The order of the values in each list is preserved in the new list, and
+the new list uses the collator of the first list.
+
+ReadOnly() returns a read-only view of the specified list.  The view does not
+copy the list, so any changes made to the list are visible through the view,
+but the list cannot be changed through the view.
 */
 type ListClassLike[V any] interface {
 	// Constructor Methods
 	List() ListLike[V]
+	ListWithCollator(
+		collator age.CollatorLike[V],
+	) ListLike[V]
 	ListFromArray(
 		values []V,
 	) ListLike[V]
@@ -137,6 +356,82 @@ type ListClassLike[V any] interface {
 		first ListLike[V],
 		second ListLike[V],
 	) ListLike[V]
+	ReadOnly(
+		list ListLike[V],
+	) ListViewLike[V]
+}
+
+/*
+MetricsClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+metrics-like class.
+
+A metrics-like class captures the activity of a measurable queue at a moment in
+time.  Each queue registers itself when it is created and remains registered
+until it is no longer referenced, so Snapshot() returns the current metrics for
+each live queue (e.g. each queue in a pipeline created by the Fork(), Split()
+and Join() queue class functions).  Exporter() returns an exporter whose String()
+method returns the current metrics for each live queue as a JSON array.  It can
+be published using expvar.Publish().
+*/
+type MetricsClassLike interface {
+	// Constructor Methods
+	Metrics(
+		name string,
+		capacity uint,
+		enqueued uint64,
+		dequeued uint64,
+		depth uint,
+		highWaterMark uint,
+		producerBlocked tim.Duration,
+		consumerBlocked tim.Duration,
+	) MetricsLike
+
+	// Function Methods
+	Snapshot() Sequential[MetricsLike]
+	Exporter() fmt.Stringer
+}
+
+/*
+PersistentQueueClassLike[V any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete persistent-queue-like class.
+
+A persistent-queue-like class has the same first-in-first-out (FIFO) and
+capacity semantics as a queue-like class, but each value that is added to it is
+first written to a write-ahead log in the specified directory on the local disk.
+The log is made up of segment files, each value record of which is protected by
+a checksum.  A value that is removed from the queue with a receipt remains in
+the log until it has been acknowledged, so if the process fails before the value
+is acknowledged it is delivered again after the queue is reopened (at-least-once
+delivery).  A partially written record at the end of the log is discarded when
+the queue is reopened.  Only one queue at a time may use a directory.
+
+The oldest segment files are deleted once each value that they contain has been
+acknowledged and a new segment is being written.  A segment file is kept while
+any older segment file remains since it may contain acknowledgements of values
+in the older segment.  Compact() rewrites all values that have not yet been
+acknowledged to a new segment and deletes the others.
+
+The values are encoded using an encoder from the agents package unless encoding
+and decoding functions are specified.  An error accessing the disk will result
+in a panic.
+*/
+type PersistentQueueClassLike[V any] interface {
+	// Constructor Methods
+	PersistentQueue(
+		directory string,
+	) PersistentQueueLike[V]
+	PersistentQueueWithCapacity(
+		directory string,
+		capacity uint,
+	) PersistentQueueLike[V]
+	PersistentQueueWithCodec(
+		directory string,
+		capacity uint,
+		encoder EncodingFunction[V],
+		decoder DecodingFunction[V],
+	) PersistentQueueLike[V]
 }
 
 /*
@@ -173,6 +468,26 @@ output queue returns the new output queue. Each value removed from each input
 queue will automatically be added to the output queue.  This pattern is useful
 when the results of the processing with a Split() function need to be
 consolidated into a single queue.
+
+Throttle() connects the output of the specified input queue with a new output
+queue and returns the new output queue.  Each value removed from the input queue
+is added to the output queue no faster than the specified finite rate (in values
+per second), although up to burst values may be added at once after a quiet
+period.  This pattern is useful when the values feed a downstream service that
+enforces strict throughput limits.  ThrottleWithClock() does the same using the
+specified clock, which allows the pacing to be tested deterministically.
+
+Each of these functions stops reading from its input queues once one of its
+output queues has been closed, and then closes the rest of its output queues.
+
+Select() waits until one of the specified queues has a value and then removes
+that value and returns it along with the index of its queue in the sequence.
+When several queues have values one of them is chosen at random so that no
+queue is starved.  A queue that is closed and empty is no longer waited on, and
+once all of the queues are closed and empty an index of -1 is returned with ok
+set to false.  SelectWithContext() also returns an index of -1 with ok set to
+false when the specified context is done.  The queues must have been created by
+this class.
 */
 type QueueClassLike[V any] interface {
 	// Constructor Methods
@@ -202,6 +517,34 @@ type QueueClassLike[V any] interface {
 		group Synchronized,
 		inputs Sequential[QueueLike[V]],
 	) QueueLike[V]
+	Throttle(
+		group Synchronized,
+		input QueueLike[V],
+		rate float64,
+		burst uint,
+	) QueueLike[V]
+	ThrottleWithClock(
+		group Synchronized,
+		input QueueLike[V],
+		rate float64,
+		burst uint,
+		clock Clock,
+	) QueueLike[V]
+	Select(
+		queues Sequential[QueueLike[V]],
+	) (
+		index int,
+		value V,
+		ok bool,
+	)
+	SelectWithContext(
+		context ctx.Context,
+		queues Sequential[QueueLike[V]],
+	) (
+		index int,
+		value V,
+		ok bool,
+	)
 }
 
 /*
@@ -226,6 +569,10 @@ set but not in the second specified set.
 
 Xor() returns a new set containing the values that are in the first specified
 set or the second specified set but not both.
+
+ReadOnly() returns a read-only view of the specified set.  The view does not
+copy the set, so any changes made to the set are visible through the view, but
+the set cannot be changed through the view.
 */
 type SetClassLike[V any] interface {
 	// Constructor Methods
@@ -257,6 +604,9 @@ type SetClassLike[V any] interface {
 		first SetLike[V],
 		second SetLike[V],
 	) SetLike[V]
+	ReadOnly(
+		set SetLike[V],
+	) SetViewLike[V]
 }
 
 /*
@@ -299,6 +649,9 @@ type AssociationLike[K comparable, V any] interface {
 	SetValue(
 		value V,
 	)
+
+	// Aspect Interfaces
+	age.Serializable
 }
 
 /*
@@ -309,13 +662,172 @@ supported by each instance of a concrete catalog-like class.
 type CatalogLike[K comparable, V any] interface {
 	// Principal Methods
 	GetClass() CatalogClassLike[K, V]
+	Begin() CatalogTransactionLike[K, V]
+	BeginWithHistory() CatalogTransactionLike[K, V]
 
 	// Aspect Interfaces
 	Associative[K, V]
+	Observable[AssociationLike[K, V]]
+	age.Serializable
 	Sequential[AssociationLike[K, V]]
 	Sortable[AssociationLike[K, V]]
 }
 
+/*
+CatalogTransactionLike[K comparable, V any] is an instance interface that
+declares the complete set of principal, attribute and aspect methods that must
+be supported by each transaction on a catalog.
+
+A transaction collects the changes made through it without affecting its
+catalog until it is committed, at which point all of the changes are applied to
+the catalog at once.  If it is rolled back instead, none of the changes are
+applied.  While a transaction is in progress its catalog is locked, so any
+attempt to change the catalog directly or to begin another transaction on it
+will result in a panic.  When a committed catalog is observed, its listeners are passed the net changes to its
+keys as a single batch of events.
+
+A transaction that was begun with history also allows each change made through
+it to be undone and then redone.  Since the transaction maintains its changes
+in a persistent immutable catalog, each step in its history costs very little.
+Once a transaction has been committed or rolled back it may no longer be used.
+*/
+type CatalogTransactionLike[K comparable, V any] interface {
+	// Principal Methods
+	Commit()
+	Rollback()
+	Undo()
+	Redo()
+
+	// Attribute Methods
+	CanUndo() bool
+	CanRedo() bool
+
+	// Aspect Interfaces
+	Associative[K, V]
+	Sequential[AssociationLike[K, V]]
+}
+
+/*
+CatalogViewLike[K comparable, V any] is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each read-only view of a catalog.
+*/
+type CatalogViewLike[K comparable, V any] interface {
+	// Principal Methods
+	AsCatalog() CatalogLike[K, V]
+
+	// Aspect Interfaces
+	Keyed[K, V]
+	Sequential[AssociationLike[K, V]]
+}
+
+/*
+EventLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete event-like class.
+*/
+type EventLike[V any] interface {
+	// Principal Methods
+	GetClass() EventClassLike[V]
+
+	// Attribute Methods
+	GetChange() Change
+	GetFirst() int
+	GetLast() int
+	GetValues() Sequential[V]
+	GetPrevious() Sequential[V]
+}
+
+/*
+ImmutableCatalogLike[K comparable, V any] is an instance interface that
+declares the complete set of principal, attribute and aspect methods that must
+be supported by each instance of a concrete immutable-catalog-like class.
+*/
+type ImmutableCatalogLike[K comparable, V any] interface {
+	// Principal Methods
+	GetClass() ImmutableCatalogClassLike[K, V]
+	AsCatalog() CatalogLike[K, V]
+	WithValue(
+		key K,
+		value V,
+	) ImmutableCatalogLike[K, V]
+	WithoutValue(
+		key K,
+	) ImmutableCatalogLike[K, V]
+
+	// Aspect Interfaces
+	Keyed[K, V]
+	Sequential[AssociationLike[K, V]]
+}
+
+/*
+ImmutableListLike[V any] is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+instance of a concrete immutable-list-like class.
+*/
+type ImmutableListLike[V any] interface {
+	// Principal Methods
+	GetClass() ImmutableListClassLike[V]
+	AsList() ListLike[V]
+	WithValue(
+		index int,
+		value V,
+	) ImmutableListLike[V]
+	WithAppendedValue(
+		value V,
+	) ImmutableListLike[V]
+	WithAppendedValues(
+		values Sequential[V],
+	) ImmutableListLike[V]
+	WithInsertedValue(
+		slot uint,
+		value V,
+	) ImmutableListLike[V]
+	WithoutValue(
+		index int,
+	) ImmutableListLike[V]
+	WithoutLastValue() ImmutableListLike[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
+
+	// Aspect Interfaces
+	Accessible[V]
+	Searchable[V]
+	Sequential[V]
+}
+
+/*
+ImmutableSetLike[V any] is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete immutable-set-like class.
+*/
+type ImmutableSetLike[V any] interface {
+	// Principal Methods
+	GetClass() ImmutableSetClassLike[V]
+	AsSet() SetLike[V]
+	WithValue(
+		value V,
+	) ImmutableSetLike[V]
+	WithValues(
+		values Sequential[V],
+	) ImmutableSetLike[V]
+	WithoutValue(
+		value V,
+	) ImmutableSetLike[V]
+	WithoutValues(
+		values Sequential[V],
+	) ImmutableSetLike[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
+
+	// Aspect Interfaces
+	Accessible[V]
+	Searchable[V]
+	Sequential[V]
+}
+
 /*
 ListLike[V any] is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
@@ -324,16 +836,143 @@ instance of a concrete list-like class.
 type ListLike[V any] interface {
 	// Principal Methods
 	GetClass() ListClassLike[V]
+	Begin() ListTransactionLike[V]
+	BeginWithHistory() ListTransactionLike[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
 
 	// Aspect Interfaces
 	Accessible[V]
+	Bisectable[V]
 	Malleable[V]
+	Observable[V]
 	Searchable[V]
+	age.Serializable
 	Sequential[V]
 	Sortable[V]
 	Updatable[V]
 }
 
+/*
+ListTransactionLike[V any] is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+transaction on a list.
+
+A transaction collects the changes made through it without affecting its list
+until it is committed, at which point all of the changes are applied to the
+list at once.  If it is rolled back instead, none of the changes are applied.
+While a transaction is in progress its list is locked, so any attempt to change
+the list directly or to begin another transaction on it will result in a panic.
+When a committed list is observed, its listeners are passed the events for each
+change made through the transaction as a single batch.
+
+A transaction that was begun with history also allows each change made through
+it to be undone and then redone.  Once a transaction has been committed or
+rolled back it may no longer be used.
+*/
+type ListTransactionLike[V any] interface {
+	// Principal Methods
+	Commit()
+	Rollback()
+	Undo()
+	Redo()
+
+	// Attribute Methods
+	CanUndo() bool
+	CanRedo() bool
+
+	// Aspect Interfaces
+	Accessible[V]
+	Malleable[V]
+	Searchable[V]
+	Sequential[V]
+	Updatable[V]
+}
+
+/*
+ListViewLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each read-only
+view of a list.
+*/
+type ListViewLike[V any] interface {
+	// Principal Methods
+	AsList() ListLike[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
+
+	// Aspect Interfaces
+	Accessible[V]
+	Searchable[V]
+	Sequential[V]
+}
+
+/*
+MetricsLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete metrics-like class.
+
+The producer and consumer blocked durations are the cumulative amounts of time
+that go-routines have spent waiting to add values to a full queue and to remove
+values from an empty queue (including while selecting from it), up to the moment
+that the metrics were captured.
+*/
+type MetricsLike interface {
+	// Principal Methods
+	GetClass() MetricsClassLike
+
+	// Attribute Methods
+	GetName() string
+	GetCapacity() uint
+	GetEnqueued() uint64
+	GetDequeued() uint64
+	GetDepth() uint
+	GetHighWaterMark() uint
+	GetProducerBlocked() tim.Duration
+	GetConsumerBlocked() tim.Duration
+}
+
+/*
+PersistentQueueLike[V any] is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+instance of a concrete persistent-queue-like class.
+
+The RemoveFirstWithReceipt() method returns a receipt along with each value
+that it removes from the queue.  The value remains in the log until its receipt
+is passed to the Acknowledge() method, which returns false if the receipt is not
+for a value that is waiting to be acknowledged.  The values that are removed by
+the RemoveFirst(), RemoveAll() and Drain() methods have no receipts so they are
+acknowledged automatically.
+
+The Close() method closes the queue, closes its segment files and releases its
+directory so that it can be opened again.  Any values that have not been
+acknowledged remain in the log and are delivered again once it is reopened.
+*/
+type PersistentQueueLike[V any] interface {
+	// Principal Methods
+	GetClass() PersistentQueueClassLike[V]
+	RemoveFirstWithReceipt() (
+		first V,
+		receipt uint64,
+		ok bool,
+	)
+	Acknowledge(
+		receipt uint64,
+	) bool
+	Compact()
+	Close()
+
+	// Attribute Methods
+	GetDirectory() string
+	GetCapacity() uint
+	GetUnacknowledged() uint
+
+	// Aspect Interfaces
+	Fifo[V]
+	Sequential[V]
+}
+
 /*
 QueueLike[V any] is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
@@ -348,6 +987,8 @@ type QueueLike[V any] interface {
 
 	// Aspect Interfaces
 	Fifo[V]
+	Measurable
+	age.Serializable
 	Sequential[V]
 }
 
@@ -366,6 +1007,26 @@ type SetLike[V any] interface {
 	// Aspect Interfaces
 	Accessible[V]
 	Elastic[V]
+	Observable[V]
+	Searchable[V]
+	age.Serializable
+	Sequential[V]
+}
+
+/*
+SetViewLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each read-only
+view of a set.
+*/
+type SetViewLike[V any] interface {
+	// Principal Methods
+	AsSet() SetLike[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
+
+	// Aspect Interfaces
+	Accessible[V]
 	Searchable[V]
 	Sequential[V]
 }
@@ -384,6 +1045,7 @@ type StackLike[V any] interface {
 
 	// Aspect Interfaces
 	Lifo[V]
+	age.Serializable
 	Sequential[V]
 }
 
@@ -428,21 +1090,14 @@ method signatures that must be supported by each instance of an associative
 concrete class.
 
 An associative class maintains a sequence of generic typed key-value
-associations.
+associations that can be changed.
 */
 type Associative[K comparable, V any] interface {
-	AsMap() map[K]V
-	GetValue(
-		key K,
-	) V
+	Keyed[K, V]
 	SetValue(
 		key K,
 		value V,
 	)
-	GetKeys() Sequential[K]
-	GetValues(
-		keys Sequential[K],
-	) Sequential[V]
 	RemoveValue(
 		key K,
 	) V
@@ -452,6 +1107,58 @@ type Associative[K comparable, V any] interface {
 	RemoveAll()
 }
 
+/*
+Bisectable[V any] is an aspect interface that declares a set of method
+signatures that must be supported by each instance of a bisectable concrete
+class.
+
+A bisectable class can search its sequence of values in O[log(n)] time when the
+values are sorted using the same ranking function that is passed to each
+method.  The results are unspecified if the values are not sorted that way.
+
+SearchValue() returns the index of the first value that is equal to the
+specified value and true, or if there is no such value, the slot in which the
+value could be inserted and false.  LowerBound() returns the slot before the
+first value that is not ranked before the specified value, and UpperBound()
+returns the slot after the last value that is not ranked after it.  So the
+values equal to the specified value lie between the two slots.  InsertSorted()
+inserts the specified value after any values equal to it, keeping the values
+sorted.
+*/
+type Bisectable[V any] interface {
+	SearchValue(
+		value V,
+		ranker age.RankingFunction[V],
+	) (
+		index int,
+		found bool,
+	)
+	LowerBound(
+		value V,
+		ranker age.RankingFunction[V],
+	) uint
+	UpperBound(
+		value V,
+		ranker age.RankingFunction[V],
+	) uint
+	InsertSorted(
+		value V,
+		ranker age.RankingFunction[V],
+	)
+}
+
+/*
+Clock is an aspect interface that declares a set of method signatures that must
+be supported by each instance of a concrete clock class.  A clock provides the
+current time and pauses the calling go-routine for a duration.
+*/
+type Clock interface {
+	Now() tim.Time
+	Sleep(
+		duration tim.Duration,
+	)
+}
+
 /*
 Elastic[V any] is an aspect interface that declares a set of method signatures
 that must be supported by each instance of an elastic concrete class.
@@ -476,17 +1183,48 @@ type Elastic[V any] interface {
 Fifo[V any] is an aspect interface that declares a set of method signatures
 that must be supported by each instance of a synchronized first-in-first-out
 channel concrete class.
+
+A channel is open until it is closed, after which no more values can be added
+to it.  AddValue() returns false if the channel was closed before the value
+could be added, including while it was blocked waiting for capacity.  The values
+that were added before the channel was closed may still be removed, and once
+the channel is both closed and empty RemoveFirst() returns with ok set to false.
+Drain() removes and returns the values that are currently available without
+blocking, and RemoveAll() does the same but discards them.  Neither reopens a
+closed channel.  CloseAndWait() closes the channel and then blocks until each
+remaining value has been removed.
 */
 type Fifo[V any] interface {
 	AddValue(
 		value V,
-	)
+	) bool
 	RemoveFirst() (
 		first V,
 		ok bool,
 	)
 	RemoveAll()
+	Drain() Sequential[V]
 	CloseChannel()
+	CloseAndWait()
+	IsClosed() bool
+}
+
+/*
+Keyed[K comparable, V any] is an aspect interface that declares a set of method
+signatures that must be supported by each instance of a keyed concrete class.
+
+A keyed class maintains a sequence of generic typed key-value associations whose
+values can be retrieved using their keys.
+*/
+type Keyed[K comparable, V any] interface {
+	AsMap() map[K]V
+	GetValue(
+		key K,
+	) V
+	GetKeys() Sequential[K]
+	GetValues(
+		keys Sequential[K],
+	) Sequential[V]
 }
 
 /*
@@ -532,6 +1270,44 @@ type Malleable[V any] interface {
 	RemoveAll()
 }
 
+/*
+Measurable is an aspect interface that declares a set of method signatures that
+must be supported by each instance of a measurable concrete class.  The name is
+optional and is only used to identify the instance in its metrics.
+*/
+type Measurable interface {
+	GetName() string
+	SetName(
+		name string,
+	)
+	GetMetrics() MetricsLike
+}
+
+/*
+Observable[V any] is an aspect interface that declares a set of method
+signatures that must be supported by each instance of an observable concrete
+class.
+
+An observable class notifies each subscribed listener function of the changes
+made to it.  A listener is normally passed each event as it happens, but any
+bulk operation (e.g. AppendValues(), RemoveAll() or SortValues()) is reported
+as a single event.  All events generated while the function passed to Batch()
+is running are passed to each listener together once it returns.  If the
+function panics instead, its events are discarded and the panic continues, so
+listeners are never passed the events of an incomplete batch.  Changes made
+to the values themselves (e.g. by calling SetValue() on an association) are not
+observed.  The collection does not synchronize its listeners, so it should only
+be changed by one goroutine at a time.
+*/
+type Observable[V any] interface {
+	Subscribe(
+		listener ListenerFunction[V],
+	) Subscription
+	Batch(
+		function func(),
+	)
+}
+
 /*
 Searchable[V any] is an aspect interface that declares a set of method
 signatures that must be supported by each instance of a searchable concrete
@@ -568,6 +1344,16 @@ class.
 
 A sortable class allows its sequence of values to be sorted using a specific
 sorting algorithm.
+
+When only the k values ranked first are needed the rest of the values need not
+be sorted.  The rank k is ordinal, so the first ranked value has a rank of 1.
+SelectValue() reorders the values so that the value with rank k is in position
+k and returns it, and PartialSort() reorders the values so that the k values
+ranked first are sorted at the start of the sequence.  TopValues() returns the
+k values ranked first in sorted order without reordering the sequence.
+
+The values may be shuffled using a specific random source (e.g. a seeded one
+for reproducible results).
 */
 type Sortable[V any] interface {
 	SortValues()
@@ -576,6 +1362,32 @@ type Sortable[V any] interface {
 	)
 	ReverseValues()
 	ShuffleValues()
+	ShuffleValuesWithSource(
+		source rnd.Source,
+	)
+	SelectValue(
+		k uint,
+		ranker age.RankingFunction[V],
+	) V
+	PartialSort(
+		k uint,
+		ranker age.RankingFunction[V],
+	)
+	TopValues(
+		k uint,
+		ranker age.RankingFunction[V],
+	) Sequential[V]
+}
+
+/*
+Subscription is an aspect interface that declares a set of method signatures
+that must be supported by each subscription of a listener function to an
+observable collection.  Once a subscription has been canceled its listener is
+no longer notified of any changes.
+*/
+type Subscription interface {
+	Cancel()
+	IsActive() bool
 }
 
 /*
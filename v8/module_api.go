@@ -34,6 +34,9 @@ import (
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	col "github.com/craterdog/go-collection-framework/v8/collections"
 	ran "github.com/craterdog/go-collection-framework/v8/ranges"
+	io "io"
+	rnd "math/rand/v2"
+	tim "time"
 )
 
 // TYPE ALIASES
@@ -41,7 +44,24 @@ import (
 // Agents
 
 type (
-	Rank = age.Rank
+	Algorithm = age.Algorithm
+	Operation = age.Operation
+	Rank      = age.Rank
+)
+
+const (
+	MergeSort     = age.MergeSort
+	PdqSort       = age.PdqSort
+	TimSort       = age.TimSort
+	RadixSort     = age.RadixSort
+	InsertionSort = age.InsertionSort
+)
+
+const (
+	KeepEdit   = age.KeepEdit
+	InsertEdit = age.InsertEdit
+	RemoveEdit = age.RemoveEdit
+	ChangeEdit = age.ChangeEdit
 )
 
 const (
@@ -51,49 +71,109 @@ const (
 )
 
 type (
-	RankingFunction[V any] = age.RankingFunction[V]
+	ExtractorFunction[V any, K any] = age.ExtractorFunction[V, K]
+	RankingFunction[V any]          = age.RankingFunction[V]
+)
+
+type (
+	CollatorClassLike[V any]                = age.CollatorClassLike[V]
+	DecoderClassLike                        = age.DecoderClassLike
+	DifferClassLike[V any]                  = age.DifferClassLike[V]
+	EditClassLike[V any]                    = age.EditClassLike[V]
+	EncoderClassLike                        = age.EncoderClassLike
+	KeyDifferClassLike[V any, K comparable] = age.KeyDifferClassLike[V, K]
+	KeyRankerClassLike[V any, K any]        = age.KeyRankerClassLike[V, K]
+	RankerClassLike[V any]                  = age.RankerClassLike[V]
+	SorterClassLike[V any]                  = age.SorterClassLike[V]
 )
 
 type (
-	CollatorClassLike[V any] = age.CollatorClassLike[V]
-	SorterClassLike[V any]   = age.SorterClassLike[V]
+	CollatorLike[V any]                = age.CollatorLike[V]
+	DecoderLike                        = age.DecoderLike
+	DifferLike[V any]                  = age.DifferLike[V]
+	EditLike[V any]                    = age.EditLike[V]
+	EncoderLike                        = age.EncoderLike
+	KeyDifferLike[V any, K comparable] = age.KeyDifferLike[V, K]
+	SorterLike[V any]                  = age.SorterLike[V]
 )
 
 type (
-	CollatorLike[V any] = age.CollatorLike[V]
-	SorterLike[V any]   = age.SorterLike[V]
+	Serializable = age.Serializable
 )
 
 // Collections
 
 type (
-	AssociationClassLike[K comparable, V any] = col.AssociationClassLike[K, V]
-	CatalogClassLike[K comparable, V any]     = col.CatalogClassLike[K, V]
-	ListClassLike[V any]                      = col.ListClassLike[V]
-	QueueClassLike[V any]                     = col.QueueClassLike[V]
-	SetClassLike[V any]                       = col.SetClassLike[V]
-	StackClassLike[V any]                     = col.StackClassLike[V]
+	Change = col.Change
+)
+
+const (
+	Inserted   = col.Inserted
+	Removed    = col.Removed
+	Replaced   = col.Replaced
+	Reordered  = col.Reordered
+	KeyAdded   = col.KeyAdded
+	KeyUpdated = col.KeyUpdated
+	KeyRemoved = col.KeyRemoved
 )
 
 type (
-	AssociationLike[K comparable, V any] = col.AssociationLike[K, V]
-	CatalogLike[K comparable, V any]     = col.CatalogLike[K, V]
-	ListLike[V any]                      = col.ListLike[V]
-	QueueLike[V any]                     = col.QueueLike[V]
-	SetLike[V any]                       = col.SetLike[V]
-	StackLike[V any]                     = col.StackLike[V]
+	DecodingFunction[V any] = col.DecodingFunction[V]
+	EncodingFunction[V any] = col.EncodingFunction[V]
+	ListenerFunction[V any] = col.ListenerFunction[V]
+)
+
+type (
+	AssociationClassLike[K comparable, V any]      = col.AssociationClassLike[K, V]
+	CatalogClassLike[K comparable, V any]          = col.CatalogClassLike[K, V]
+	EventClassLike[V any]                          = col.EventClassLike[V]
+	ImmutableCatalogClassLike[K comparable, V any] = col.ImmutableCatalogClassLike[K, V]
+	ImmutableListClassLike[V any]                  = col.ImmutableListClassLike[V]
+	ImmutableSetClassLike[V any]                   = col.ImmutableSetClassLike[V]
+	ListClassLike[V any]                           = col.ListClassLike[V]
+	MetricsClassLike                               = col.MetricsClassLike
+	PersistentQueueClassLike[V any]                = col.PersistentQueueClassLike[V]
+	QueueClassLike[V any]                          = col.QueueClassLike[V]
+	SetClassLike[V any]                            = col.SetClassLike[V]
+	StackClassLike[V any]                          = col.StackClassLike[V]
+)
+
+type (
+	AssociationLike[K comparable, V any]        = col.AssociationLike[K, V]
+	CatalogLike[K comparable, V any]            = col.CatalogLike[K, V]
+	CatalogTransactionLike[K comparable, V any] = col.CatalogTransactionLike[K, V]
+	CatalogViewLike[K comparable, V any]        = col.CatalogViewLike[K, V]
+	EventLike[V any]                            = col.EventLike[V]
+	ImmutableCatalogLike[K comparable, V any]   = col.ImmutableCatalogLike[K, V]
+	ImmutableListLike[V any]                    = col.ImmutableListLike[V]
+	ImmutableSetLike[V any]                     = col.ImmutableSetLike[V]
+	ListLike[V any]                             = col.ListLike[V]
+	ListTransactionLike[V any]                  = col.ListTransactionLike[V]
+	ListViewLike[V any]                         = col.ListViewLike[V]
+	MetricsLike                                 = col.MetricsLike
+	PersistentQueueLike[V any]                  = col.PersistentQueueLike[V]
+	QueueLike[V any]                            = col.QueueLike[V]
+	SetLike[V any]                              = col.SetLike[V]
+	SetViewLike[V any]                          = col.SetViewLike[V]
+	StackLike[V any]                            = col.StackLike[V]
 )
 
 type (
 	Accessible[V any]                = col.Accessible[V]
 	Associative[K comparable, V any] = col.Associative[K, V]
+	Bisectable[V any]                = col.Bisectable[V]
+	Clock                            = col.Clock
 	Elastic[V any]                   = col.Elastic[V]
 	Fifo[V any]                      = col.Fifo[V]
+	Keyed[K comparable, V any]       = col.Keyed[K, V]
 	Lifo[V any]                      = col.Lifo[V]
+	Measurable                       = col.Measurable
 	Malleable[V any]                 = col.Malleable[V]
+	Observable[V any]                = col.Observable[V]
 	Searchable[V any]                = col.Searchable[V]
 	Sequential[V any]                = col.Sequential[V]
 	Sortable[V any]                  = col.Sortable[V]
+	Subscription                     = col.Subscription
 	Synchronized                     = col.Synchronized
 	Updatable[V any]                 = col.Updatable[V]
 )
@@ -110,22 +190,36 @@ const (
 )
 
 type (
-	ContinuumClassLike[V ran.Continuous] = ran.ContinuumClassLike[V]
-	IntervalClassLike[V ran.Discrete]    = ran.IntervalClassLike[V]
-	SpectrumClassLike[V ran.Ordered[V]]  = ran.SpectrumClassLike[V]
+	FactoryFunction[V any] = ran.FactoryFunction[V]
+)
+
+type (
+	ContinuumClassLike[V ran.Continuous]                 = ran.ContinuumClassLike[V]
+	DurationClassLike                                    = ran.DurationClassLike
+	IntervalClassLike[V ran.Discrete]                    = ran.IntervalClassLike[V]
+	IntervalTreeClassLike[R ran.Ranged[E], E any, V any] = ran.IntervalTreeClassLike[R, E, V]
+	MomentClassLike                                      = ran.MomentClassLike
+	SpectrumClassLike[V ran.Ordered[V]]                  = ran.SpectrumClassLike[V]
 )
 
 type (
-	ContinuumLike[V ran.Continuous] = ran.ContinuumLike[V]
-	IntervalLike[V ran.Discrete]    = ran.IntervalLike[V]
-	SpectrumLike[V ran.Ordered[V]]  = ran.SpectrumLike[V]
+	ContinuumLike[V ran.Continuous]                 = ran.ContinuumLike[V]
+	DayLike                                         = ran.DayLike
+	DurationLike                                    = ran.DurationLike
+	HourLike                                        = ran.HourLike
+	IntervalLike[V ran.Discrete]                    = ran.IntervalLike[V]
+	IntervalTreeLike[R ran.Ranged[E], E any, V any] = ran.IntervalTreeLike[R, E, V]
+	MomentLike                                      = ran.MomentLike
+	SpectrumLike[V ran.Ordered[V]]                  = ran.SpectrumLike[V]
 )
 
 type (
-	Bounded[V any] = ran.Bounded[V]
-	Continuous     = ran.Continuous
-	Discrete       = ran.Discrete
-	Ordered[V any] = ran.Ordered[V]
+	Bounded[V any]    = ran.Bounded[V]
+	Continuous        = ran.Continuous
+	Discrete          = ran.Discrete
+	Ordered[V any]    = ran.Ordered[V]
+	Ranged[V any]     = ran.Ranged[V]
+	Successive[V any] = ran.Successive[V]
 )
 
 // CLASS ACCESSORS
@@ -148,6 +242,102 @@ func CollatorWithMaximumDepth[V any](
 	)
 }
 
+func DecoderClass() DecoderClassLike {
+	return age.DecoderClass()
+}
+
+func Decoder(
+	reader io.Reader,
+) DecoderLike {
+	return DecoderClass().Decoder(
+		reader,
+	)
+}
+
+func DifferClass[V any]() DifferClassLike[V] {
+	return age.DifferClass[V]()
+}
+
+func Differ[V any]() DifferLike[V] {
+	return DifferClass[V]().Differ()
+}
+
+func DifferWithCollator[V any](
+	collator age.CollatorLike[V],
+) DifferLike[V] {
+	return DifferClass[V]().DifferWithCollator(
+		collator,
+	)
+}
+
+func EditClass[V any]() EditClassLike[V] {
+	return age.EditClass[V]()
+}
+
+func Edit[V any](
+	operation age.Operation,
+	value V,
+) EditLike[V] {
+	return EditClass[V]().Edit(
+		operation,
+		value,
+	)
+}
+
+func EditWithPrevious[V any](
+	operation age.Operation,
+	value V,
+	previous V,
+) EditLike[V] {
+	return EditClass[V]().EditWithPrevious(
+		operation,
+		value,
+		previous,
+	)
+}
+
+func EncoderClass() EncoderClassLike {
+	return age.EncoderClass()
+}
+
+func Encoder(
+	writer io.Writer,
+) EncoderLike {
+	return EncoderClass().Encoder(
+		writer,
+	)
+}
+
+func KeyDifferClass[V any, K comparable]() KeyDifferClassLike[V, K] {
+	return age.KeyDifferClass[V, K]()
+}
+
+func KeyDiffer[V any, K comparable](
+	extractor age.ExtractorFunction[V, K],
+) KeyDifferLike[V, K] {
+	return KeyDifferClass[V, K]().KeyDiffer(
+		extractor,
+	)
+}
+
+func KeyDifferWithCollator[V any, K comparable](
+	extractor age.ExtractorFunction[V, K],
+	collator age.CollatorLike[V],
+) KeyDifferLike[V, K] {
+	return KeyDifferClass[V, K]().KeyDifferWithCollator(
+		extractor,
+		collator,
+	)
+}
+
+func KeyRankerClass[V any, K any]() KeyRankerClassLike[V, K] {
+	return age.KeyRankerClass[V, K]()
+}
+
+func RankerClass[V any]() RankerClassLike[V] {
+	return age.RankerClass[V]()
+}
+
 func SorterClass[V any]() SorterClassLike[V] {
 	return age.SorterClass[V]()
 }
@@ -164,6 +354,36 @@ func SorterWithRanker[V any](
 	)
 }
 
+func SorterWithParallelism[V any](
+	ranker age.RankingFunction[V],
+	workers uint,
+) SorterLike[V] {
+	return SorterClass[V]().SorterWithParallelism(
+		ranker,
+		workers,
+	)
+}
+
+func SorterWithAlgorithm[V any](
+	ranker age.RankingFunction[V],
+	algorithm age.Algorithm,
+) SorterLike[V] {
+	return SorterClass[V]().SorterWithAlgorithm(
+		ranker,
+		algorithm,
+	)
+}
+
+func SorterWithRandomSource[V any](
+	ranker age.RankingFunction[V],
+	source rnd.Source,
+) SorterLike[V] {
+	return SorterClass[V]().SorterWithRandomSource(
+		ranker,
+		source,
+	)
+}
+
 // Collections
 
 func AssociationClass[K comparable, V any]() AssociationClassLike[K, V] {
@@ -212,6 +432,124 @@ func CatalogFromSequence[K comparable, V any](
 	)
 }
 
+func EventClass[V any]() EventClassLike[V] {
+	return col.EventClass[V]()
+}
+
+func Event[V any](
+	change col.Change,
+	first int,
+	last int,
+	values col.Sequential[V],
+	previous col.Sequential[V],
+) EventLike[V] {
+	return EventClass[V]().Event(
+		change,
+		first,
+		last,
+		values,
+		previous,
+	)
+}
+
+func ImmutableCatalogClass[K comparable, V any]() ImmutableCatalogClassLike[K, V] {
+	return col.ImmutableCatalogClass[K, V]()
+}
+
+func ImmutableCatalog[K comparable, V any]() ImmutableCatalogLike[K, V] {
+	return ImmutableCatalogClass[K, V]().ImmutableCatalog()
+}
+
+func ImmutableCatalogFromMap[K comparable, V any](
+	associations map[K]V,
+) ImmutableCatalogLike[K, V] {
+	return ImmutableCatalogClass[K, V]().ImmutableCatalogFromMap(
+		associations,
+	)
+}
+
+func ImmutableCatalogFromSequence[K comparable, V any](
+	associations col.Sequential[col.AssociationLike[K, V]],
+) ImmutableCatalogLike[K, V] {
+	return ImmutableCatalogClass[K, V]().ImmutableCatalogFromSequence(
+		associations,
+	)
+}
+
+func ImmutableListClass[V any]() ImmutableListClassLike[V] {
+	return col.ImmutableListClass[V]()
+}
+
+func ImmutableList[V any]() ImmutableListLike[V] {
+	return ImmutableListClass[V]().ImmutableList()
+}
+
+func ImmutableListWithCollator[V any](
+	collator age.CollatorLike[V],
+) ImmutableListLike[V] {
+	return ImmutableListClass[V]().ImmutableListWithCollator(
+		collator,
+	)
+}
+
+func ImmutableListFromArray[V any](
+	values []V,
+) ImmutableListLike[V] {
+	return ImmutableListClass[V]().ImmutableListFromArray(
+		values,
+	)
+}
+
+func ImmutableListFromSequence[V any](
+	values col.Sequential[V],
+) ImmutableListLike[V] {
+	return ImmutableListClass[V]().ImmutableListFromSequence(
+		values,
+	)
+}
+
+func ImmutableSetClass[V any]() ImmutableSetClassLike[V] {
+	return col.ImmutableSetClass[V]()
+}
+
+func ImmutableSet[V any]() ImmutableSetLike[V] {
+	return ImmutableSetClass[V]().ImmutableSet()
+}
+
+func ImmutableSetWithCollator[V any](
+	collator age.CollatorLike[V],
+) ImmutableSetLike[V] {
+	return ImmutableSetClass[V]().ImmutableSetWithCollator(
+		collator,
+	)
+}
+
+func ImmutableSetFromArray[V any](
+	values []V,
+) ImmutableSetLike[V] {
+	return ImmutableSetClass[V]().ImmutableSetFromArray(
+		values,
+	)
+}
+
+func ImmutableSetFromSequence[V any](
+	values col.Sequential[V],
+) ImmutableSetLike[V] {
+	return ImmutableSetClass[V]().ImmutableSetFromSequence(
+		values,
+	)
+}
+
+func ImmutableSetFromSequenceWithCollator[V any](
+	values col.Sequential[V],
+	collator age.CollatorLike[V],
+) ImmutableSetLike[V] {
+	return ImmutableSetClass[V]().ImmutableSetFromSequenceWithCollator(
+		values,
+		collator,
+	)
+}
+
 func ListClass[V any]() ListClassLike[V] {
 	return col.ListClass[V]()
 }
@@ -220,6 +558,14 @@ func List[V any]() ListLike[V] {
 	return ListClass[V]().List()
 }
 
+func ListWithCollator[V any](
+	collator age.CollatorLike[V],
+) ListLike[V] {
+	return ListClass[V]().ListWithCollator(
+		collator,
+	)
+}
+
 func ListFromArray[V any](
 	values []V,
 ) ListLike[V] {
@@ -236,6 +582,68 @@ func ListFromSequence[V any](
 	)
 }
 
+func MetricsClass() MetricsClassLike {
+	return col.MetricsClass()
+}
+
+func Metrics(
+	name string,
+	capacity uint,
+	enqueued uint64,
+	dequeued uint64,
+	depth uint,
+	highWaterMark uint,
+	producerBlocked tim.Duration,
+	consumerBlocked tim.Duration,
+) MetricsLike {
+	return MetricsClass().Metrics(
+		name,
+		capacity,
+		enqueued,
+		dequeued,
+		depth,
+		highWaterMark,
+		producerBlocked,
+		consumerBlocked,
+	)
+}
+
+func PersistentQueueClass[V any]() PersistentQueueClassLike[V] {
+	return col.PersistentQueueClass[V]()
+}
+
+func PersistentQueue[V any](
+	directory string,
+) PersistentQueueLike[V] {
+	return PersistentQueueClass[V]().PersistentQueue(
+		directory,
+	)
+}
+
+func PersistentQueueWithCapacity[V any](
+	directory string,
+	capacity uint,
+) PersistentQueueLike[V] {
+	return PersistentQueueClass[V]().PersistentQueueWithCapacity(
+		directory,
+		capacity,
+	)
+}
+
+func PersistentQueueWithCodec[V any](
+	directory string,
+	capacity uint,
+	encoder col.EncodingFunction[V],
+	decoder col.DecodingFunction[V],
+) PersistentQueueLike[V] {
+	return PersistentQueueClass[V]().PersistentQueueWithCodec(
+		directory,
+		capacity,
+		encoder,
+		decoder,
+	)
+}
+
 func QueueClass[V any]() QueueClassLike[V] {
 	return col.QueueClass[V]()
 }
@@ -352,6 +760,26 @@ func Continuum[V Continuous](
 	)
 }
 
+func DurationClass() DurationClassLike {
+	return ran.DurationClass()
+}
+
+func Duration(
+	duration tim.Duration,
+) DurationLike {
+	return DurationClass().Duration(
+		duration,
+	)
+}
+
+func DurationFromString(
+	source string,
+) DurationLike {
+	return DurationClass().DurationFromString(
+		source,
+	)
+}
+
 func IntervalClass[V Discrete]() IntervalClassLike[V] {
 	return ran.IntervalClass[V]()
 }
@@ -370,6 +798,58 @@ func Interval[V Discrete](
 	)
 }
 
+func IntervalWithFactory[V Discrete](
+	left ran.Bracket,
+	minimum V,
+	maximum V,
+	right ran.Bracket,
+	factory ran.FactoryFunction[V],
+) IntervalLike[V] {
+	return IntervalClass[V]().IntervalWithFactory(
+		left,
+		minimum,
+		maximum,
+		right,
+		factory,
+	)
+}
+
+func IntervalTreeClass[R Ranged[E], E any, V any]() IntervalTreeClassLike[R, E, V] {
+	return ran.IntervalTreeClass[R, E, V]()
+}
+
+func IntervalTree[R Ranged[E], E any, V any]() IntervalTreeLike[R, E, V] {
+	return IntervalTreeClass[R, E, V]().IntervalTree()
+}
+
+func IntervalTreeWithRanker[R Ranged[E], E any, V any](
+	ranker age.RankingFunction[E],
+) IntervalTreeLike[R, E, V] {
+	return IntervalTreeClass[R, E, V]().IntervalTreeWithRanker(
+		ranker,
+	)
+}
+
+func MomentClass() MomentClassLike {
+	return ran.MomentClass()
+}
+
+func Moment(
+	time tim.Time,
+) MomentLike {
+	return MomentClass().Moment(
+		time,
+	)
+}
+
+func MomentFromString(
+	source string,
+) MomentLike {
+	return MomentClass().MomentFromString(
+		source,
+	)
+}
+
 func SpectrumClass[V Ordered[V]]() SpectrumClassLike[V] {
 	return ran.SpectrumClass[V]()
 }
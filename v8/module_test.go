@@ -13,13 +13,21 @@
 package module_test
 
 import (
+	byt "bytes"
+	ctx "context"
 	fmt "fmt"
 	fra "github.com/craterdog/go-collection-framework/v8"
 	ass "github.com/stretchr/testify/assert"
+	io "io"
 	mat "math"
+	ran "math/rand/v2"
+	osx "os"
+	pat "path/filepath"
 	sli "slices"
+	str "strings"
 	syn "sync"
 	tes "testing"
+	tim "time"
 )
 
 func TestModuleFunctions(t *tes.T) {
@@ -1144,6 +1152,394 @@ func TestSortingStrings(t *tes.T) {
 
 // COLLECTIONS
 
+type Pair struct {
+	Key   int
+	Order int
+}
+
+func rankPairs(first, second Pair) fra.Rank {
+	switch {
+	case first.Key < second.Key:
+		return fra.LesserRank
+	case first.Key > second.Key:
+		return fra.GreaterRank
+	default:
+		return fra.EqualRank
+	}
+}
+
+func generatePairs(size int) []Pair {
+	var generator = ran.New(ran.NewPCG(1, 2))
+	var pairs = make([]Pair, size)
+	for index := range pairs {
+		pairs[index] = Pair{Key: generator.IntN(size / 10), Order: index}
+	}
+	return pairs
+}
+
+func TestSortingIsStable(t *tes.T) {
+	var sorter = fra.SorterWithRanker[Pair](rankPairs)
+	var pairs = generatePairs(1000)
+	sorter.SortValues(pairs)
+	ass.True(t, sli.IsSortedFunc(pairs, func(first, second Pair) int {
+		if first.Key != second.Key {
+			return first.Key - second.Key
+		}
+		return first.Order - second.Order
+	}))
+}
+
+func TestSortingWithParallelism(t *tes.T) {
+	var sequential = fra.SorterWithRanker[Pair](rankPairs)
+	ass.Equal(t, 1, int(sequential.GetWorkers()))
+	var expected = generatePairs(50000)
+	sequential.SortValues(expected)
+	for _, workers := range []uint{2, 3, 7, 8} {
+		var concurrent = fra.SorterWithParallelism[Pair](rankPairs, workers)
+		ass.Equal(t, workers, concurrent.GetWorkers())
+		var pairs = generatePairs(50000)
+		concurrent.SortValues(pairs)
+		ass.Equal(t, expected, pairs)
+	}
+
+	// The default collator may be shared by the workers.
+	var collator = fra.Collator[int]()
+	var sorter = fra.SorterWithParallelism[int](collator.RankValues, 0)
+	ass.True(t, sorter.GetWorkers() > 0)
+	var values = make([]int, 10000)
+	for index := range values {
+		values[index] = len(values) - index
+	}
+	sorter.SortValues(values)
+	ass.True(t, sli.IsSorted(values))
+}
+
+func TestSortingAlgorithms(t *tes.T) {
+	var generator = ran.New(ran.NewPCG(3, 4))
+	var inputs = [][]Pair{
+		{},
+		{{Key: 1}},
+		generatePairs(20),
+		generatePairs(5000),
+	}
+	var ascending = generatePairs(3000)
+	sli.SortStableFunc(ascending, func(first, second Pair) int {
+		return first.Key - second.Key
+	})
+	var descending = sli.Clone(ascending)
+	sli.Reverse(descending)
+	var appended = append(sli.Clone(ascending), generatePairs(100)...)
+	var runs []Pair
+	for range 20 {
+		var run = generatePairs(10 + generator.IntN(200))
+		sli.SortStableFunc(run, func(first, second Pair) int {
+			return first.Key - second.Key
+		})
+		runs = append(runs, run...)
+	}
+	inputs = append(inputs, ascending, descending, appended, runs)
+
+	var merge = fra.SorterWithRanker[Pair](rankPairs)
+	for _, algorithm := range []fra.Algorithm{fra.PdqSort, fra.TimSort, fra.InsertionSort} {
+		var sorter = fra.SorterWithAlgorithm[Pair](rankPairs, algorithm)
+		ass.Equal(t, algorithm, sorter.GetAlgorithm())
+		for _, input := range inputs {
+			var expected = sli.Clone(input)
+			merge.SortValues(expected)
+			var actual = sli.Clone(input)
+			sorter.SortValues(actual)
+			if algorithm == fra.PdqSort {
+				// A pattern-defeating quicksort is not stable.
+				for index := range expected {
+					ass.Equal(t, expected[index].Key, actual[index].Key)
+				}
+			} else {
+				ass.Equal(t, expected, actual, algorithm.String())
+			}
+		}
+	}
+}
+
+func TestRadixSorting(t *tes.T) {
+	var generator = ran.New(ran.NewPCG(5, 6))
+	var integers = make([]int, 5000)
+	for index := range integers {
+		integers[index] = generator.IntN(2000) - 1000
+	}
+	integers = append(integers, mat.MinInt, mat.MaxInt, 0)
+	var expected = sli.Clone(integers)
+	fra.Sorter[int]().SortValues(expected)
+	var radix = fra.SorterWithAlgorithm[int](fra.Collator[int]().RankValues, fra.RadixSort)
+	radix.SortValues(integers)
+	ass.Equal(t, expected, integers)
+
+	var bytes = []byte("the quick brown fox jumps over the lazy dog")
+	fra.SorterWithAlgorithm[byte](fra.Collator[byte]().RankValues, fra.RadixSort).SortValues(bytes)
+	ass.Equal(t, "        abcdeeefghhijklmnoooopqrrsttuuvwxyz", string(bytes))
+
+	var words = []string{"beta", "", "alphabet", "alpha", "b", "gamma", "alpha", "al", "delta"}
+	var strings = fra.SorterWithAlgorithm[string](fra.Collator[string]().RankValues, fra.RadixSort)
+	strings.SortValues(words)
+	ass.Equal(t, []string{"", "al", "alpha", "alpha", "alphabet", "b", "beta", "delta", "gamma"}, words)
+
+	// A ranking function that is not the natural one is still honored.
+	var natural = fra.Collator[int]().RankValues
+	var reversed = func(first, second int) fra.Rank {
+		return natural(second, first)
+	}
+	sli.Reverse(expected)
+	integers = sli.Clone(expected)
+	ran.New(ran.NewPCG(7, 6)).Shuffle(len(integers), func(first, second int) {
+		integers[first], integers[second] = integers[second], integers[first]
+	})
+	fra.SorterWithAlgorithm[int](reversed, fra.RadixSort).SortValues(integers)
+	ass.Equal(t, expected, integers)
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The values of type module_test.Pair cannot be sorted using a radix sort.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.SorterWithAlgorithm[Pair](rankPairs, fra.RadixSort).SortValues(generatePairs(10))
+}
+
+func TestSelectingValues(t *tes.T) {
+	var generator = ran.New(ran.NewPCG(7, 8))
+	var sorter = fra.SorterWithRanker[int](fra.Collator[int]().RankValues)
+	for _, size := range []int{1, 10, 1000} {
+		var values = make([]int, size)
+		for index := range values {
+			values[index] = generator.IntN(size/2 + 1)
+		}
+		var sorted = sli.Sorted(sli.Values(values))
+		for _, k := range []uint{1, uint(size+1) / 2, uint(size)} {
+			var selected = sli.Clone(values)
+			ass.Equal(t, sorted[k-1], sorter.SelectValue(selected, k))
+			for index, value := range selected {
+				if uint(index) < k {
+					ass.True(t, value <= selected[k-1])
+				} else {
+					ass.True(t, value >= selected[k-1])
+				}
+			}
+			var partial = sli.Clone(values)
+			sorter.PartialSort(partial, k)
+			ass.Equal(t, sorted[:k], partial[:k])
+			ass.Equal(t, sorted[:k], sorter.TopValues(values, k))
+		}
+	}
+	var equal = make([]int, 500)
+	ass.Equal(t, 0, sorter.SelectValue(equal, 250))
+	ass.Equal(t, []int{}, sorter.TopValues(equal, 0))
+
+	// The top values retain their original relative order.
+	var pairs = generatePairs(1000)
+	var expected = sli.Clone(pairs)
+	fra.SorterWithRanker[Pair](rankPairs).SortValues(expected)
+	var top = fra.SorterWithRanker[Pair](rankPairs).TopValues(pairs, 50)
+	ass.Equal(t, expected[:50], top)
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The rank 0 must be in the range [1..500].", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	sorter.SelectValue(equal, 0)
+}
+
+func TestSortableTopValues(t *tes.T) {
+	var list = fra.ListFromArray[string]([]string{"foxtrot", "alpha", "echo", "charlie", "delta", "bravo"})
+	var ranker = fra.Collator[string]().RankValues
+	ass.Equal(t, []string{"alpha", "bravo", "charlie"}, list.TopValues(3, ranker).AsArray())
+	ass.Equal(t, "foxtrot", list.GetValue(1))
+	ass.Equal(t, "charlie", list.SelectValue(3, ranker))
+	list.PartialSort(2, ranker)
+	ass.Equal(t, []string{"alpha", "bravo"}, list.GetValues(1, 2).AsArray())
+
+	var catalog = fra.Catalog[string, int]()
+	catalog.SetValue("alpha", 3)
+	catalog.SetValue("bravo", 1)
+	catalog.SetValue("charlie", 5)
+	catalog.SetValue("delta", 2)
+	var descending = fra.Collator[int]().RankValues
+	var byValue = func(first, second fra.AssociationLike[string, int]) fra.Rank {
+		return descending(second.GetValue(), first.GetValue())
+	}
+	var top = catalog.TopValues(2, byValue).AsArray()
+	ass.Equal(t, "charlie", top[0].GetKey())
+	ass.Equal(t, "alpha", top[1].GetKey())
+	catalog.PartialSort(1, byValue)
+	ass.Equal(t, 5, catalog.AsArray()[0].GetValue())
+	ass.Equal(t, 3, catalog.GetValue("alpha"))
+}
+
+func TestShufflingWithRandomSource(t *tes.T) {
+	var ranker = fra.Collator[int]().RankValues
+	var first = fra.SorterWithRandomSource[int](ranker, ran.NewPCG(9, 10))
+	var second = fra.SorterWithRandomSource[int](ranker, ran.NewPCG(9, 10))
+	var values = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	var shuffled = sli.Clone(values)
+	first.ShuffleValues(shuffled)
+	var reproduced = sli.Clone(values)
+	second.ShuffleValues(reproduced)
+	ass.Equal(t, shuffled, reproduced)
+	ass.Equal(t, values, sli.Sorted(sli.Values(shuffled)))
+
+	// Each of the possible orders is equally likely.
+	var counts = map[[3]int]int{}
+	for range 60000 {
+		var triple = [3]int{1, 2, 3}
+		first.ShuffleValues(triple[:])
+		counts[triple]++
+	}
+	ass.Equal(t, 6, len(counts))
+	for _, count := range counts {
+		ass.InDelta(t, 10000, count, 500)
+	}
+
+	var list = fra.ListFromArray[int](values)
+	list.ShuffleValuesWithSource(ran.NewPCG(9, 10))
+	ass.Equal(t, shuffled, list.AsArray())
+}
+
+func TestSamplingWithRandomSource(t *tes.T) {
+	var ranker = fra.Collator[string]().RankValues
+	var sorter = fra.SorterWithRandomSource[string](ranker, ran.NewPCG(11, 12))
+	var values = []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	var sample = sorter.SampleValues(values, 3)
+	ass.Equal(t, 3, len(sample))
+	ass.Equal(t, 3, len(fra.SetFromArray[string](sample).AsArray()))
+	for _, value := range sample {
+		ass.True(t, sli.Contains(values, value))
+	}
+	ass.Equal(t, 5, len(sorter.SampleValues(values, 10)))
+	ass.Equal(t, []string{"alpha", "beta", "gamma", "delta", "epsilon"}, values)
+
+	var weights = []float64{1, 0, 8, 0, 1}
+	var counts = map[string]int{}
+	for range 10000 {
+		var chosen = sorter.SampleWeightedValues(values, weights, 1)
+		counts[chosen[0]]++
+	}
+	ass.Equal(t, 0, counts["beta"]+counts["delta"])
+	ass.InDelta(t, 8000, counts["gamma"], 300)
+	ass.Equal(t, 3, len(sorter.SampleWeightedValues(values, weights, 5)))
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "Each weight must be a finite non-negative number: -1", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	sorter.SampleWeightedValues(values, []float64{1, 1, 1, 1, -1}, 2)
+}
+
+type Employee struct {
+	Name    string
+	Salary  int
+	Manager *Employee
+}
+
+func TestCompositeRankers(t *tes.T) {
+	var boss = &Employee{Name: "Zoe", Salary: 300}
+	var employees = []*Employee{
+		{Name: "bob", Salary: 100, Manager: boss},
+		{Name: "Alice", Salary: 200, Manager: boss},
+		{Name: "carol", Salary: 100, Manager: boss},
+		boss,
+		nil,
+	}
+	var rankers = fra.RankerClass[*Employee]()
+	var bySalary = fra.KeyRankerClass[*Employee, int]().RankBy(func(employee *Employee) int {
+		return employee.Salary
+	})
+	var byName = fra.KeyRankerClass[*Employee, string]().RankBy(func(employee *Employee) string {
+		return employee.Name
+	})
+	var ranker = rankers.NilsLast(rankers.ThenBy(rankers.Reversed(bySalary), byName))
+	var sorter = fra.SorterWithRanker[*Employee](ranker)
+	sorter.SortValues(employees)
+	var names []string
+	for _, employee := range employees[:4] {
+		names = append(names, employee.Name)
+	}
+	ass.Equal(t, []string{"Zoe", "Alice", "bob", "carol"}, names)
+	ass.Nil(t, employees[4])
+	ranker = rankers.NilsFirst(byName)
+	ass.Equal(t, fra.LesserRank, ranker(nil, boss))
+	ass.Equal(t, fra.GreaterRank, ranker(boss, nil))
+	ass.Equal(t, fra.EqualRank, ranker(nil, nil))
+
+	// A key may also be ranked using a specific ranking function.
+	var byFolded = fra.KeyRankerClass[*Employee, string]().RankByWithRanker(
+		func(employee *Employee) string {
+			return employee.Name
+		},
+		fra.RankerClass[string]().IgnoringCase(),
+	)
+	fra.SorterWithRanker[*Employee](byFolded).SortValues(employees[:4])
+	names = nil
+	for _, employee := range employees[:4] {
+		names = append(names, employee.Name)
+	}
+	ass.Equal(t, []string{"Alice", "bob", "carol", "Zoe"}, names)
+
+	var words = fra.ListFromArray[string]([]string{"file10", "File2", "file1", "FILE02", "alpha"})
+	words.SortValuesWithRanker(fra.RankerClass[string]().Naturally())
+	ass.Equal(t, []string{"alpha", "file1", "File2", "FILE02", "file10"}, words.AsArray())
+	words.SortValuesWithRanker(fra.RankerClass[string]().IgnoringCase())
+	ass.Equal(t, []string{"alpha", "FILE02", "file1", "file10", "File2"}, words.AsArray())
+
+	var catalog = fra.Catalog[string, int]()
+	catalog.SetValue("gamma", 2)
+	catalog.SetValue("alpha", 3)
+	catalog.SetValue("beta", 2)
+	var associations = fra.AssociationClass[string, int]()
+	catalog.SortValuesWithRanker(
+		fra.RankerClass[fra.AssociationLike[string, int]]().ThenBy(
+			associations.RankByValue(fra.Collator[int]().RankValues),
+			associations.RankByKey(fra.Collator[string]().RankValues),
+		),
+	)
+	ass.Equal(t, []string{"beta", "gamma", "alpha"}, catalog.GetKeys().AsArray())
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The values of type int cannot be ranked as strings.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.RankerClass[int]().Naturally()
+}
+
+func BenchmarkSortValues(b *tes.B) {
+	var collator = fra.Collator[Pair]()
+	var sorter = fra.SorterWithRanker[Pair](collator.RankValues)
+	var unsorted = generatePairs(100000)
+	var values = make([]Pair, len(unsorted))
+	for b.Loop() {
+		copy(values, unsorted)
+		sorter.SortValues(values)
+	}
+}
+
+func BenchmarkSortValuesWithParallelism(b *tes.B) {
+	var collator = fra.Collator[Pair]()
+	var sorter = fra.SorterWithParallelism[Pair](collator.RankValues, 0)
+	var unsorted = generatePairs(100000)
+	var values = make([]Pair, len(unsorted))
+	for b.Loop() {
+		copy(values, unsorted)
+		sorter.SortValues(values)
+	}
+}
+
 func TestCatalogConstructors(t *tes.T) {
 	var class = fra.CatalogClass[rune, int64]()
 	class.Catalog()
@@ -1224,6 +1620,1282 @@ func TestCatalogsWithStringsAndIntegers(t *tes.T) {
 	ass.True(t, catalog.GetSize() == 0)
 }
 
+func TestCatalogsWithManyRemovals(t *tes.T) {
+	var catalog = fra.Catalog[int, string]()
+	for index := range 10000 {
+		catalog.SetValue(index, fmt.Sprint(index))
+	}
+	for index := 0; index < 10000; index += 2 {
+		ass.Equal(t, fmt.Sprint(index), catalog.RemoveValue(index))
+	}
+	ass.Equal(t, 5000, int(catalog.GetSize()))
+	ass.Equal(t, "", catalog.RemoveValue(0))
+	ass.Equal(t, "", catalog.GetValue(2))
+	ass.Equal(t, "3", catalog.GetValue(3))
+	var keys = catalog.GetKeys().AsArray()
+	ass.Equal(t, []int{1, 3, 5}, keys[:3])
+	ass.Equal(t, 9999, keys[len(keys)-1])
+
+	// A key that is set again is moved to the end.
+	catalog.RemoveValue(1)
+	catalog.SetValue(1, "one")
+	catalog.SetValue(4, "four")
+	var associations = catalog.AsArray()
+	ass.Equal(t, 3, associations[0].GetKey())
+	ass.Equal(t, "one", associations[len(associations)-2].GetValue())
+	ass.Equal(t, 4, associations[len(associations)-1].GetKey())
+
+	catalog.RemoveValues(fra.ListFromArray[int]([]int{3, 5, 7}))
+	catalog.SortValues()
+	ass.Equal(t, []int{1, 4, 9, 11}, catalog.GetKeys().AsArray()[:4])
+	ass.Equal(t, "four", catalog.GetValue(4))
+	catalog.ReverseValues()
+	ass.Equal(t, 9999, catalog.AsArray()[0].GetKey())
+	catalog.RemoveValue(9999)
+	ass.Equal(t, 9997, catalog.GetIterator().GetNext().GetKey())
+
+	// Reading a catalog that contains tombstones does not change it so it may
+	// be read concurrently through a read-only view.
+	catalog.RemoveValues(fra.ListFromArray([]int{9997, 9995, 9993}))
+	var view = fra.CatalogClass[int, string]().ReadOnly(catalog)
+	var ranker = func(first, second fra.AssociationLike[int, string]) fra.Rank {
+		return fra.Collator[int]().RankValues(first.GetKey(), second.GetKey())
+	}
+	var group syn.WaitGroup
+	var arrays = make([][]fra.AssociationLike[int, string], 8)
+	for index := range arrays {
+		group.Go(func() {
+			if index%2 == 0 {
+				arrays[index] = catalog.AsArray()
+			} else {
+				arrays[index] = view.AsArray()
+			}
+		})
+	}
+	group.Wait()
+	for _, array := range arrays {
+		ass.Equal(t, 4994, len(array))
+		ass.Equal(t, 9991, array[0].GetKey())
+	}
+	ass.Equal(t, 1, catalog.TopValues(1, ranker).AsArray()[0].GetKey())
+	catalog.RemoveAll()
+	ass.True(t, catalog.IsEmpty())
+}
+
+func TestImmutableLists(t *tes.T) {
+	var empty = fra.ImmutableList[int]()
+	ass.True(t, empty.IsEmpty())
+	var expected []int
+	var list = empty
+	for index := range 2000 {
+		list = list.WithAppendedValue(index)
+		expected = append(expected, index)
+	}
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, expected, list.AsArray())
+	ass.Equal(t, expected, fra.ImmutableListFromArray(expected).AsArray())
+	ass.Equal(t, 1500, list.GetValue(1501))
+	ass.Equal(t, 1999, list.GetValue(-1))
+	ass.Equal(t, 1024, list.GetIndex(1023))
+	ass.Equal(t, 0, list.GetIndex(2000))
+	ass.True(t, list.ContainsAll(fra.ListFromArray([]int{0, 1055, 1999})))
+
+	// Changing a value leaves the original list unchanged.
+	var changed = list.WithValue(5, -5).WithValue(-1, -1999)
+	ass.Equal(t, -5, changed.GetValue(5))
+	ass.Equal(t, -1999, changed.GetValue(2000))
+	ass.Equal(t, 4, list.GetValue(5))
+	ass.Equal(t, 1999, list.GetValue(2000))
+	ass.Equal(t, []int{8, 9, 10}, list.GetValues(9, 11).AsArray())
+
+	// Remove the values one at a time so the trie shrinks back down.
+	for list.GetSize() > 0 {
+		expected = expected[:len(expected)-1]
+		list = list.WithoutLastValue()
+		if len(expected)%97 == 0 || len(expected) < 40 {
+			ass.Equal(t, expected, list.AsArray())
+		}
+	}
+	ass.Equal(t, 2000, int(changed.GetSize()))
+
+	var iterator = changed.GetIterator()
+	ass.Equal(t, 0, iterator.GetNext())
+	iterator.ToEnd()
+	ass.Equal(t, -1999, iterator.GetPrevious())
+	var mutable = changed.AsList()
+	mutable.SetValue(1, 42)
+	ass.Equal(t, 0, changed.GetValue(1))
+	list = fra.ImmutableListFromSequence[int](mutable).WithAppendedValues(mutable)
+	ass.Equal(t, 4000, int(list.GetSize()))
+	ass.Contains(t, fmt.Sprint(fra.ImmutableListFromArray([]int{1, 2, 3})), "immutableList_")
+
+	// Values can be inserted or removed at any slot.
+	var digits = fra.ImmutableListFromArray([]int{1, 2, 4, 5})
+	var inserted = digits.WithInsertedValue(2, 3)
+	ass.Equal(t, []int{1, 2, 3, 4, 5}, inserted.AsArray())
+	ass.Equal(t, []int{0, 1, 2, 4, 5}, digits.WithInsertedValue(0, 0).AsArray())
+	ass.Equal(t, []int{1, 2, 4, 5, 6}, digits.WithInsertedValue(4, 6).AsArray())
+	ass.Equal(t, []int{1, 2, 4, 5}, inserted.WithoutValue(3).AsArray())
+	ass.Equal(t, []int{1, 2, 3, 4}, inserted.WithoutValue(-1).AsArray())
+	ass.Equal(t, []int{1, 2, 4, 5}, digits.AsArray())
+	var large = fra.ImmutableListFromArray(sli.Repeat([]int{7}, 1000)).WithInsertedValue(500, 8)
+	ass.Equal(t, 1001, int(large.GetSize()))
+	ass.Equal(t, 8, large.GetValue(501))
+	ass.Equal(t, 7, large.WithoutValue(501).GetValue(501))
+
+	// Random insertions and removals match those on an array.
+	var generator = ran.New(ran.NewPCG(3, 5))
+	var values = sli.Repeat([]int{0}, 3000)
+	list = fra.ImmutableListFromArray(values)
+	for index := range 300 {
+		var slot = generator.IntN(len(values) + 1)
+		values = sli.Insert(values, slot, index)
+		list = list.WithInsertedValue(uint(slot), index)
+		slot = generator.IntN(len(values))
+		values = sli.Delete(values, slot, slot+1)
+		list = list.WithoutValue(slot + 1)
+	}
+	ass.Equal(t, values, list.AsArray())
+
+	// The values before the slot are shared rather than copied.
+	large = fra.ImmutableListFromArray(sli.Repeat([]int{7}, 100000))
+	var allocations = tes.AllocsPerRun(10, func() {
+		large.WithInsertedValue(99990, 8)
+		large.WithoutValue(-10)
+	})
+	ass.Less(t, allocations, 200.0)
+
+	// The collator of the list is used to find values.
+	var parity = &parityCollator{}
+	var collated = fra.ImmutableListWithCollator[int](parity).WithAppendedValues(fra.ListFromArray([]int{2, 4, 5}))
+	ass.Same(t, parity, collated.GetCollator())
+	ass.Equal(t, 3, collated.GetIndex(7))
+	ass.Equal(t, 2, collated.WithInsertedValue(1, 3).WithoutValue(-1).GetIndex(1))
+	ass.Same(t, parity, fra.ImmutableListFromSequence(collated.GetValues(1, 2)).GetCollator())
+	ass.Equal(t, 1, collated.AsList().GetIndex(6))
+	ass.Same(t, parity, fra.ImmutableListFromSequence[int](collated.AsList()).GetCollator())
+	ass.Equal(t, 0, fra.ImmutableListFromArray([]int{2, 4, 5}).GetIndex(7))
+	ass.Panics(t, func() {
+		fra.ImmutableListWithCollator[int](nil)
+	})
+}
+
+type parityCollator struct{}
+
+func (v *parityCollator) GetClass() fra.CollatorClassLike[int] {
+	return fra.CollatorClass[int]()
+}
+
+func (v *parityCollator) CompareValues(first int, second int) bool {
+	return first%2 == second%2
+}
+
+func (v *parityCollator) RankValues(first int, second int) fra.Rank {
+	return fra.Collator[int]().RankValues(first%2, second%2)
+}
+
+func (v *parityCollator) GetMaximumDepth() uint {
+	return 16
+}
+
+func TestImmutableSets(t *tes.T) {
+	var values []int
+	for index := range 1000 {
+		values = append(values, (index*37)%500)
+	}
+	var set = fra.ImmutableSetFromArray(values)
+	ass.Equal(t, 500, int(set.GetSize()))
+	ass.Equal(t, 0, set.GetValue(1))
+	ass.Equal(t, 499, set.GetValue(-1))
+	ass.Equal(t, 101, set.GetIndex(100))
+	ass.Equal(t, 0, set.GetIndex(500))
+	ass.Equal(t, []int{10, 11, 12}, set.GetValues(11, 13).AsArray())
+
+	// Adding or removing values leaves the original set unchanged.
+	var odd = set
+	for value := 0; value < 500; value += 2 {
+		odd = odd.WithoutValue(value)
+	}
+	ass.Equal(t, 250, int(odd.GetSize()))
+	ass.Equal(t, 500, int(set.GetSize()))
+	ass.True(t, odd.ContainsValue(1))
+	ass.False(t, odd.ContainsValue(2))
+	ass.Equal(t, 3, odd.GetValue(2))
+	ass.Same(t, odd, odd.WithValue(1))
+	ass.Same(t, odd, odd.WithoutValue(2))
+	var more = odd.WithValues(fra.ListFromArray([]int{-1, 2, 1000}))
+	ass.Equal(t, []int{-1, 1, 2, 3}, more.AsArray()[:4])
+	ass.Equal(t, 1000, more.GetValue(-1))
+	ass.Equal(t, 250, int(odd.GetSize()))
+	more = more.WithoutValues(odd)
+	ass.Equal(t, []int{-1, 2, 1000}, more.AsArray())
+
+	var mutable = more.AsSet()
+	mutable.AddValue(5)
+	ass.Equal(t, 4, int(mutable.GetSize()))
+	ass.Equal(t, 3, int(more.GetSize()))
+	var empty = fra.ImmutableSetWithCollator(fra.Collator[int]())
+	ass.True(t, empty.IsEmpty())
+	ass.False(t, empty.WithValue(1).IsEmpty())
+	ass.True(t, empty.IsEmpty())
+
+	// The collator of the sequence is used unless another one is specified.
+	var descending = &descendingCollator{}
+	var custom = fra.SetWithCollator[int](descending)
+	custom.AddValues(fra.ListFromArray([]int{1, 3, 2}))
+	var immutable = fra.ImmutableSetFromSequence[int](custom)
+	ass.Same(t, descending, immutable.GetCollator())
+	ass.Equal(t, []int{3, 2, 1}, immutable.AsArray())
+	immutable = fra.ImmutableSetFromSequenceWithCollator[int](custom, fra.Collator[int]())
+	ass.Equal(t, []int{1, 2, 3}, immutable.AsArray())
+	immutable = fra.ImmutableSetFromSequenceWithCollator[int](fra.ListFromArray([]int{2, 1, 2}), descending)
+	ass.Equal(t, []int{2, 1}, immutable.AsArray())
+}
+
+type descendingCollator struct{}
+
+func (v *descendingCollator) GetClass() fra.CollatorClassLike[int] {
+	return fra.CollatorClass[int]()
+}
+
+func (v *descendingCollator) CompareValues(first int, second int) bool {
+	return first == second
+}
+
+func (v *descendingCollator) RankValues(first int, second int) fra.Rank {
+	return fra.Collator[int]().RankValues(second, first)
+}
+
+func (v *descendingCollator) GetMaximumDepth() uint {
+	return 16
+}
+
+func TestImmutableCatalogs(t *tes.T) {
+	var empty = fra.ImmutableCatalog[string, int]()
+	var catalog = empty.WithValue("foo", 1).WithValue("bar", 2).WithValue("baz", 3)
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, []string{"foo", "bar", "baz"}, catalog.GetKeys().AsArray())
+	ass.Equal(t, 2, catalog.GetValue("bar"))
+	ass.Equal(t, 0, catalog.GetValue("qux"))
+
+	// Changing the value of a key retains its position.
+	var changed = catalog.WithValue("foo", 5)
+	ass.Equal(t, []string{"foo", "bar", "baz"}, changed.GetKeys().AsArray())
+	ass.Equal(t, []int{5, 3}, changed.GetValues(fra.ListFromArray([]string{"foo", "baz"})).AsArray())
+	ass.Equal(t, 1, catalog.GetValue("foo"))
+
+	// The associations are copies so changing them has no effect.
+	var association = changed.GetIterator().GetNext()
+	association.SetValue(42)
+	ass.Equal(t, 5, changed.GetValue("foo"))
+
+	var removed = changed.WithoutValue("bar")
+	ass.Same(t, removed, removed.WithoutValue("bar"))
+	ass.Equal(t, map[string]int{"foo": 5, "baz": 3}, removed.AsMap())
+	ass.Equal(t, 3, int(changed.GetSize()))
+	removed = removed.WithValue("bar", 6)
+	ass.Equal(t, []string{"foo", "baz", "bar"}, removed.GetKeys().AsArray())
+
+	var mutable = removed.AsCatalog()
+	mutable.SetValue("foo", 7)
+	ass.Equal(t, 5, removed.GetValue("foo"))
+	var copied = fra.ImmutableCatalogFromSequence[string, int](mutable)
+	ass.Equal(t, 7, copied.GetValue("foo"))
+	var sorted = fra.ImmutableCatalogFromMap(map[string]int{"b": 2, "a": 1})
+	ass.Equal(t, "a", sorted.AsArray()[0].GetKey())
+}
+
+func TestImmutableCatalogsWithManyKeys(t *tes.T) {
+	var catalog = fra.ImmutableCatalog[int, string]()
+	for index := range 10000 {
+		catalog = catalog.WithValue(index, fmt.Sprint(index))
+	}
+	var original = catalog
+	for index := 0; index < 10000; index += 2 {
+		catalog = catalog.WithoutValue(index)
+	}
+	ass.Equal(t, 5000, int(catalog.GetSize()))
+	ass.Equal(t, 10000, int(original.GetSize()))
+	ass.Equal(t, "", catalog.GetValue(2))
+	ass.Equal(t, "2", original.GetValue(2))
+	ass.Equal(t, "3", catalog.GetValue(3))
+	var keys = catalog.GetKeys().AsArray()
+	ass.Equal(t, []int{1, 3, 5}, keys[:3])
+	ass.Equal(t, 9999, keys[len(keys)-1])
+	for index := 1; index < 10000; index += 2 {
+		catalog = catalog.WithoutValue(index)
+	}
+	ass.True(t, catalog.IsEmpty())
+	ass.Equal(t, "4999", original.GetValue(4999))
+}
+
+func TestReadOnlyViews(t *tes.T) {
+	var list = fra.ListFromArray([]string{"foo", "bar"})
+	var listView = fra.ListClass[string]().ReadOnly(list)
+	list.AppendValue("baz")
+	ass.Equal(t, 3, int(listView.GetSize()))
+	ass.Equal(t, "baz", listView.GetValue(-1))
+	ass.Equal(t, 2, listView.GetIndex("bar"))
+	ass.True(t, listView.ContainsValue("foo"))
+	var _, ok = any(listView).(fra.ListLike[string])
+	ass.False(t, ok)
+	var copied = listView.AsList()
+	copied.RemoveAll()
+	ass.Equal(t, []string{"foo", "bar", "baz"}, listView.AsArray())
+
+	var set = fra.SetFromArray([]int{3, 1, 2})
+	var setView = fra.SetClass[int]().ReadOnly(set)
+	set.RemoveValue(2)
+	ass.Equal(t, []int{1, 3}, setView.AsArray())
+	ass.False(t, setView.ContainsValue(2))
+	ass.Equal(t, 3, int(setView.AsSet().GetValue(-1)))
+
+	var catalog = fra.Catalog[string, int]()
+	var catalogView = fra.CatalogClass[string, int]().ReadOnly(catalog)
+	ass.True(t, catalogView.IsEmpty())
+	catalog.SetValue("foo", 1)
+	catalog.SetValue("bar", 2)
+	ass.Equal(t, 2, catalogView.GetValue("bar"))
+	ass.Equal(t, []string{"foo", "bar"}, catalogView.GetKeys().AsArray())
+
+	// Changing an association retrieved through the view has no effect.
+	var association = catalogView.GetIterator().GetNext()
+	association.SetValue(5)
+	ass.Equal(t, 1, catalog.GetValue("foo"))
+	catalogView.AsCatalog().SetValue("foo", 6)
+	ass.Equal(t, map[string]int{"foo": 1, "bar": 2}, catalogView.AsMap())
+}
+
+func TestObservableLists(t *tes.T) {
+	var list = fra.List[string]()
+	var batches []fra.Sequential[fra.EventLike[string]]
+	var subscription = list.Subscribe(func(events fra.Sequential[fra.EventLike[string]]) {
+		batches = append(batches, events)
+	})
+	list.AppendValue("foo")
+	list.AppendValues(fra.ListFromArray([]string{"bar", "baz"}))
+	list.SetValue(1, "qux")
+	list.RemoveValues(2, 3)
+	ass.Equal(t, 4, len(batches))
+	var event = batches[1].AsArray()[0]
+	ass.Equal(t, fra.Inserted, event.GetChange())
+	ass.Equal(t, 2, event.GetFirst())
+	ass.Equal(t, 3, event.GetLast())
+	ass.Equal(t, []string{"bar", "baz"}, event.GetValues().AsArray())
+	event = batches[2].AsArray()[0]
+	ass.Equal(t, fra.Replaced, event.GetChange())
+	ass.Equal(t, []string{"qux"}, event.GetValues().AsArray())
+	ass.Equal(t, []string{"foo"}, event.GetPrevious().AsArray())
+	event = batches[3].AsArray()[0]
+	ass.Equal(t, fra.Removed, event.GetChange())
+	ass.Equal(t, []string{"bar", "baz"}, event.GetPrevious().AsArray())
+
+	// The events of a batch are delivered together.
+	batches = nil
+	list.Batch(func() {
+		list.InsertValue(0, "b")
+		list.InsertValue(0, "a")
+		list.SortValues()
+	})
+	ass.Equal(t, 1, len(batches))
+	var events = batches[0].AsArray()
+	ass.Equal(t, 3, len(events))
+	ass.Equal(t, fra.Reordered, events[2].GetChange())
+	ass.Equal(t, []string{"a", "b", "qux"}, events[2].GetValues().AsArray())
+
+	// The events of a batch that panics are not delivered.
+	batches = nil
+	ass.Panics(t, func() {
+		list.Batch(func() {
+			list.AppendValue("c")
+			list.GetValue(10)
+		})
+	})
+	ass.Equal(t, 0, len(batches))
+	list.AppendValue("d")
+	ass.Equal(t, 1, len(batches))
+	ass.Equal(t, 1, len(batches[0].AsArray()))
+
+	subscription.Cancel()
+	ass.False(t, subscription.IsActive())
+	list.RemoveAll()
+	ass.Equal(t, 1, len(batches))
+}
+
+func TestObservableSetsAndCatalogs(t *tes.T) {
+	var set = fra.Set[int]()
+	var changes []fra.Change
+	set.Subscribe(func(events fra.Sequential[fra.EventLike[int]]) {
+		var iterator = events.GetIterator()
+		for iterator.HasNext() {
+			changes = append(changes, iterator.GetNext().GetChange())
+		}
+	})
+	set.AddValues(fra.ListFromArray([]int{3, 1, 3}))
+	set.RemoveValue(3)
+	set.RemoveValue(5)
+	ass.Equal(t, []fra.Change{fra.Inserted, fra.Inserted, fra.Removed}, changes)
+
+	var catalog = fra.Catalog[string, int]()
+	var events []fra.EventLike[fra.AssociationLike[string, int]]
+	catalog.Subscribe(func(batch fra.Sequential[fra.EventLike[fra.AssociationLike[string, int]]]) {
+		events = append(events, batch.AsArray()...)
+	})
+	catalog.SetValue("foo", 1)
+	catalog.SetValue("foo", 2)
+	catalog.SetValue("bar", 3)
+	catalog.SortValues()
+	catalog.RemoveValue("foo")
+	ass.Equal(t, 5, len(events))
+	ass.Equal(t, fra.KeyAdded, events[0].GetChange())
+	ass.Equal(t, fra.KeyUpdated, events[1].GetChange())
+	ass.Equal(t, 1, events[1].GetPrevious().AsArray()[0].GetValue())
+	ass.Equal(t, 2, events[1].GetValues().AsArray()[0].GetValue())
+	ass.Equal(t, fra.Reordered, events[3].GetChange())
+	ass.Equal(t, "bar", events[3].GetValues().AsArray()[0].GetKey())
+	ass.Equal(t, fra.KeyRemoved, events[4].GetChange())
+
+	// Changing an association from an event does not change the catalog.
+	events[3].GetValues().AsArray()[0].SetValue(7)
+	ass.Equal(t, 3, catalog.GetValue("bar"))
+
+	// Removed associations are also passed as copies.
+	var live = catalog.AsArray()[0]
+	catalog.RemoveAll()
+	var removed = events[5].GetPrevious().AsArray()[0]
+	ass.NotSame(t, live, removed)
+	live.SetValue(9)
+	ass.Equal(t, 3, removed.GetValue())
+}
+
+func TestListTransactions(t *tes.T) {
+	var list = fra.ListFromArray([]int{1, 2, 3})
+	var events []fra.EventLike[int]
+	list.Subscribe(func(batch fra.Sequential[fra.EventLike[int]]) {
+		events = append(events, batch.AsArray()...)
+	})
+	var transaction = list.Begin()
+	transaction.AppendValue(4)
+	transaction.RemoveValue(1)
+	transaction.SetValue(1, 5)
+	ass.Equal(t, []int{5, 3, 4}, transaction.AsArray())
+	ass.Equal(t, []int{1, 2, 3}, list.AsArray())
+	ass.False(t, transaction.CanUndo())
+	ass.Equal(t, 0, len(events))
+	transaction.Commit()
+	ass.Equal(t, []int{5, 3, 4}, list.AsArray())
+	ass.Equal(t, 3, len(events))
+	ass.Panics(t, func() { transaction.AppendValue(6) })
+
+	transaction = list.Begin()
+	transaction.RemoveAll()
+	transaction.Rollback()
+	ass.Equal(t, []int{5, 3, 4}, list.AsArray())
+
+	// The list cannot be changed directly while a transaction is in progress.
+	transaction = list.Begin()
+	ass.PanicsWithValue(t, "The list cannot be changed directly while a transaction is in progress.", func() {
+		list.AppendValue(6)
+	})
+	ass.PanicsWithValue(t, "A transaction is already in progress on this list.", func() {
+		list.Begin()
+	})
+	transaction.AppendValue(6)
+	transaction.Commit()
+	ass.Equal(t, []int{5, 3, 4, 6}, list.AsArray())
+	list.RemoveValue(-1)
+
+	// Each change made with history can be undone and redone.
+	transaction = list.BeginWithHistory()
+	transaction.InsertValues(1, fra.ListFromArray([]int{7, 8}))
+	transaction.RemoveValues(2, 3)
+	transaction.SetValues(1, fra.ListFromArray([]int{0, 0, 0, 0, 0, 0}))
+	transaction.RemoveAll()
+	ass.True(t, transaction.IsEmpty())
+	transaction.Undo()
+	ass.Equal(t, []int{0, 0, 0}, transaction.AsArray())
+	transaction.Undo()
+	ass.Equal(t, []int{5, 3, 4}, transaction.AsArray())
+	transaction.Undo()
+	ass.Equal(t, []int{5, 7, 8, 3, 4}, transaction.AsArray())
+	transaction.Undo()
+	ass.False(t, transaction.CanUndo())
+	transaction.Undo()
+	ass.Equal(t, []int{5, 3, 4}, transaction.AsArray())
+	transaction.Redo()
+	ass.True(t, transaction.CanRedo())
+	ass.Equal(t, []int{5, 7, 8, 3, 4}, transaction.AsArray())
+	transaction.AppendValue(9)
+	ass.False(t, transaction.CanRedo())
+	transaction.Commit()
+	ass.Equal(t, []int{5, 7, 8, 3, 4, 9}, list.AsArray())
+}
+
+func TestCatalogTransactions(t *tes.T) {
+	var catalog = fra.CatalogFromArray([]fra.AssociationLike[string, int]{
+		fra.Association("foo", 1),
+		fra.Association("bar", 2),
+	})
+	var events []fra.EventLike[fra.AssociationLike[string, int]]
+	catalog.Subscribe(func(batch fra.Sequential[fra.EventLike[fra.AssociationLike[string, int]]]) {
+		events = append(events, batch.AsArray()...)
+	})
+	var transaction = catalog.BeginWithHistory()
+	transaction.SetValue("baz", 3)
+	transaction.SetValue("foo", 4)
+	ass.Equal(t, 2, transaction.RemoveValue("bar"))
+	ass.Equal(t, 0, transaction.GetValue("bar"))
+	ass.Equal(t, 2, catalog.GetValue("bar"))
+	transaction.Undo()
+	ass.Equal(t, []string{"foo", "bar", "baz"}, transaction.GetKeys().AsArray())
+	transaction.Undo()
+	ass.Equal(t, 1, transaction.GetValue("foo"))
+	transaction.Redo()
+	transaction.RemoveValues(fra.ListFromArray([]string{"bar", "qux"}))
+	transaction.Undo()
+	transaction.Redo()
+	ass.Equal(t, []string{"foo", "baz"}, transaction.GetKeys().AsArray())
+	transaction.Commit()
+	ass.Equal(t, []string{"foo", "baz"}, catalog.GetKeys().AsArray())
+	ass.Equal(t, 4, catalog.GetValue("foo"))
+	var changes []fra.Change
+	for _, event := range events {
+		changes = append(changes, event.GetChange())
+	}
+	ass.Equal(t, []fra.Change{fra.KeyUpdated, fra.KeyRemoved, fra.KeyAdded}, changes)
+
+	transaction = catalog.Begin()
+	transaction.RemoveAll()
+	ass.False(t, transaction.CanUndo())
+	ass.PanicsWithValue(t, "The catalog cannot be changed directly while a transaction is in progress.", func() {
+		catalog.SetValue("qux", 5)
+	})
+	ass.PanicsWithValue(t, "A transaction is already in progress on this catalog.", func() {
+		catalog.Begin()
+	})
+	transaction.Rollback()
+	ass.Equal(t, 2, int(catalog.GetSize()))
+	ass.Panics(t, func() { transaction.Commit() })
+	catalog.SetValue("qux", 5)
+	ass.Equal(t, 5, catalog.GetValue("qux"))
+}
+
+func TestDifferWithLists(t *tes.T) {
+	var differ = fra.Differ[string]()
+	var first = []string{"a", "b", "c", "a", "b", "b", "a"}
+	var second = []string{"c", "b", "a", "b", "a", "c"}
+	var edits = differ.DiffValues(first, second)
+	var changes int
+	for _, edit := range edits {
+		if edit.GetOperation() != fra.KeepEdit {
+			changes++
+		}
+	}
+	ass.Equal(t, 5, changes)
+	ass.Equal(t, second, differ.PatchValues(first, edits))
+	ass.Equal(t, 0, len(differ.DiffValues(nil, nil)))
+	ass.Equal(t, first, differ.PatchValues(nil, differ.DiffValues(nil, first)))
+
+	edits = differ.DiffValues([]string{"x", "y"}, []string{"x", "z"})
+	ass.Equal(t, "  x\n- y\n+ z\n", differ.FormatEdits(edits))
+	ass.Equal(t, "RemoveEdit", edits[1].GetOperation().String())
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The edits do not apply to the values.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	differ.PatchValues([]string{"x", "z"}, edits)
+}
+
+func TestDifferWithSetsAndCatalogs(t *tes.T) {
+	var differ = fra.Differ[int]()
+	var first = fra.SetFromArray([]int{1, 3, 5, 7})
+	var second = fra.SetFromArray([]int{3, 4, 5, 8})
+	var edits = differ.DiffMembers(first.AsArray(), second.AsArray())
+	ass.Equal(t, "- 1\n+ 4\n- 7\n+ 8\n", differ.FormatEdits(edits))
+	var patched = fra.SetFromArray(differ.PatchMembers(first.AsArray(), edits))
+	ass.Equal(t, second.AsArray(), patched.AsArray())
+
+	var previous = fra.Catalog[string, int]()
+	previous.SetValue("foo", 1)
+	previous.SetValue("bar", 2)
+	previous.SetValue("baz", 3)
+	var current = fra.Catalog[string, int]()
+	current.SetValue("baz", 3)
+	current.SetValue("foo", 4)
+	current.SetValue("qux", 5)
+	var extractor = func(association fra.AssociationLike[string, int]) string {
+		return association.GetKey()
+	}
+	var keyed = fra.KeyDiffer(extractor)
+	var changes = keyed.DiffValues(previous.AsArray(), current.AsArray())
+	ass.Equal(t, 3, len(changes))
+	ass.Equal(t, fra.ChangeEdit, changes[0].GetOperation())
+	ass.Equal(t, 1, changes[0].GetPrevious().GetValue())
+	ass.Equal(t, 4, changes[0].GetValue().GetValue())
+	ass.Equal(t, fra.RemoveEdit, changes[1].GetOperation())
+	ass.Equal(t, "bar", changes[1].GetValue().GetKey())
+	ass.Equal(t, fra.InsertEdit, changes[2].GetOperation())
+	ass.Equal(t, "qux", changes[2].GetValue().GetKey())
+	var associations = keyed.PatchValues(previous.AsArray(), changes)
+	var catalog = fra.CatalogFromArray(associations)
+	ass.Equal(t, current.AsMap(), catalog.AsMap())
+	ass.Equal(t, []string{"foo", "baz", "qux"}, catalog.GetKeys().AsArray())
+
+	// Each key must identify a single value.
+	var association = fra.Association("foo", 6)
+	ass.PanicsWithValue(t, "The key foo appears more than once in the values.", func() {
+		keyed.DiffValues(previous.AsArray(), append(current.AsArray(), association))
+	})
+	ass.PanicsWithValue(t, "The edits do not apply to the values.", func() {
+		keyed.PatchValues(current.AsArray(), changes)
+	})
+
+	// Patching a large Go array indexes it once rather than once per edit.
+	var large []fra.AssociationLike[string, int]
+	for index := range 20000 {
+		large = append(large, fra.Association(fmt.Sprint(index), index))
+	}
+	var updated = sli.Clone(large)
+	for index := 0; index < len(updated); index += 2 {
+		updated[index] = fra.Association(updated[index].GetKey(), -index-1)
+	}
+	changes = keyed.DiffValues(large, updated)
+	ass.Equal(t, 10000, len(changes))
+	ass.Equal(t, updated, keyed.PatchValues(large, changes))
+}
+
+func TestEncodingCollections(t *tes.T) {
+	var buffer byt.Buffer
+	var encoder = fra.Encoder(&buffer)
+	var list = fra.ListFromArray([]any{1, "two", 3.5, []byte("four"), nil, true})
+	var reversed = fra.Collator[string]()
+	fra.EncoderClass().RegisterCollator("reversed", reversed)
+	var set = fra.SetWithCollator(reversed)
+	set.AddValues(fra.ListFromArray([]string{"delta", "alpha", "charlie"}))
+	var catalog = fra.Catalog[string, fra.ListLike[int]]()
+	catalog.SetValue("odd", fra.ListFromArray([]int{1, 3, 5}))
+	catalog.SetValue("even", fra.ListFromArray([]int{2, 4}))
+	var stack = fra.StackFromArray([]Integer{1, 2, 3})
+	var queue = fra.QueueFromArray([]string{"first", "second"})
+	var association = fra.Association("answer", 42)
+	ass.Nil(t, encoder.EncodeValue(list))
+	ass.Nil(t, encoder.EncodeValue(set))
+	ass.Nil(t, encoder.EncodeValue(catalog))
+	ass.Nil(t, encoder.EncodeValue(stack))
+	ass.Nil(t, encoder.EncodeValue(queue))
+	ass.Nil(t, encoder.EncodeValue(association))
+
+	var decoder = fra.Decoder(&buffer)
+	var decodedList fra.ListLike[any]
+	ass.Nil(t, decoder.DecodeValue(&decodedList))
+	ass.Equal(t, 1, int(decoder.GetVersion()))
+	ass.Equal(t, list.AsArray(), decodedList.AsArray())
+	var decodedSet fra.SetLike[string]
+	ass.Nil(t, decoder.DecodeValue(&decodedSet))
+	ass.Same(t, reversed, decodedSet.GetCollator())
+	ass.Equal(t, []string{"alpha", "charlie", "delta"}, decodedSet.AsArray())
+	var decodedCatalog fra.CatalogLike[string, fra.ListLike[int]]
+	ass.Nil(t, decoder.DecodeValue(&decodedCatalog))
+	ass.Equal(t, []string{"odd", "even"}, decodedCatalog.GetKeys().AsArray())
+	ass.Equal(t, []int{2, 4}, decodedCatalog.GetValue("even").AsArray())
+	var decodedStack = fra.Stack[Integer]()
+	ass.Nil(t, decoder.DecodeValue(&decodedStack))
+	ass.Equal(t, stack.RemoveLast(), decodedStack.RemoveLast())
+	var decodedQueue fra.QueueLike[string]
+	ass.Nil(t, decoder.DecodeValue(&decodedQueue))
+	var first, _ = decodedQueue.RemoveFirst()
+	ass.Equal(t, "first", first)
+	var decodedAssociation fra.AssociationLike[string, int]
+	ass.Nil(t, decoder.DecodeValue(&decodedAssociation))
+	ass.Equal(t, 42, decodedAssociation.GetValue())
+	ass.Equal(t, io.EOF, decoder.DecodeValue(&decodedList))
+}
+
+func TestEncodingRanges(t *tes.T) {
+	var buffer byt.Buffer
+	var encoder = fra.Encoder(&buffer)
+	var glyphs = fra.Interval[Glyph](fra.Inclusive, Glyph(65), Glyph(70), fra.Exclusive)
+	var words = fra.Spectrum[Word](fra.Exclusive, Word("a"), Word("m"), fra.Inclusive)
+	var numbers = fra.Continuum[Number](fra.Inclusive, Number(-1.5), Number(mat.Inf(1)), fra.Exclusive)
+	var start = fra.Moment(tim.Date(2025, 3, 1, 0, 0, 0, 0, tim.UTC))
+	var end = fra.Moment(tim.Date(2025, 3, 3, 0, 0, 0, 0, tim.UTC))
+	var days = fra.MomentClass().Days(start, end)
+	ass.Nil(t, encoder.EncodeValue(glyphs))
+	ass.Nil(t, encoder.EncodeValue(words))
+	ass.Nil(t, encoder.EncodeValue(numbers))
+	ass.Nil(t, encoder.EncodeValue(days))
+
+	var decoder = fra.Decoder(&buffer)
+	var decodedGlyphs fra.IntervalLike[Glyph]
+	ass.Nil(t, decoder.DecodeValue(&decodedGlyphs))
+	ass.Equal(t, fmt.Sprint(glyphs), fmt.Sprint(decodedGlyphs))
+	var decodedWords fra.SpectrumLike[Word]
+	ass.Nil(t, decoder.DecodeValue(&decodedWords))
+	ass.Equal(t, fmt.Sprint(words), fmt.Sprint(decodedWords))
+	var decodedNumbers fra.ContinuumLike[Number]
+	ass.Nil(t, decoder.DecodeValue(&decodedNumbers))
+	ass.Equal(t, fmt.Sprint(numbers), fmt.Sprint(decodedNumbers))
+
+	// The factory function is retained when decoding into an existing interval.
+	var decodedDays = fra.MomentClass().Days(end, end)
+	ass.Nil(t, decoder.DecodeValue(&decodedDays))
+	ass.Equal(t, days.AsArray(), decodedDays.AsArray())
+}
+
+func TestEncodingErrors(t *tes.T) {
+	var list fra.ListLike[int]
+	var decoder = fra.Decoder(byt.NewReader(nil))
+	ass.Equal(t, io.EOF, decoder.DecodeValue(&list))
+	decoder = fra.Decoder(byt.NewReader([]byte("JSON{}")))
+	ass.Equal(t, "The stream does not contain encoded values.", decoder.DecodeValue(&list).Error())
+
+	var buffer byt.Buffer
+	fra.Encoder(&buffer).EncodeValue(fra.ListFromArray([]int{1, 2, 300}))
+	var bytes = buffer.Bytes()
+	decoder = fra.Decoder(byt.NewReader(bytes[:len(bytes)-1]))
+	ass.Equal(t, io.ErrUnexpectedEOF, decoder.DecodeValue(&list))
+	var small = fra.List[int8]()
+	decoder = fra.Decoder(byt.NewReader(bytes))
+	ass.Equal(
+		t,
+		"A value of type *collections.list_[int] cannot be decoded into a target of type collections.ListLike[int8].",
+		decoder.DecodeValue(&small).Error(),
+	)
+	ass.Equal(t, "A value of type chan int cannot be encoded.", fra.Encoder(&buffer).EncodeValue(make(chan int)).Error())
+
+	// Structures can only be decoded into interfaces if they are registered.
+	type point struct{ X, Y int }
+	buffer.Reset()
+	fra.Encoder(&buffer).EncodeValue(point{X: 1, Y: 2})
+	var value any
+	ass.Equal(
+		t,
+		"A value of kind struct cannot be decoded into a target of type interface {}.",
+		fra.Decoder(&buffer).DecodeValue(&value).Error(),
+	)
+	fra.EncoderClass().RegisterType(Pair{})
+	buffer.Reset()
+	fra.Encoder(&buffer).EncodeValue(Pair{Key: 3, Order: 4})
+	ass.Nil(t, fra.Decoder(&buffer).DecodeValue(&value))
+	ass.Equal(t, Pair{Key: 3, Order: 4}, value)
+
+	// A corrupted range is reported as an error rather than a panic.
+	buffer.Reset()
+	fra.Encoder(&buffer).EncodeValue(fra.Spectrum[Word](fra.Exclusive, Word("a"), Word("m"), fra.Inclusive))
+	bytes = byt.Replace(buffer.Bytes(), []byte("\x18\x01a"), []byte("\x18\x01z"), 1)
+	var words = fra.Spectrum[Word](fra.Inclusive, Word("b"), Word("c"), fra.Inclusive)
+	ass.Equal(
+		t,
+		"The minimum z in a spectrum must be less than the maximum m.",
+		fra.Decoder(byt.NewReader(bytes)).DecodeValue(&words).Error(),
+	)
+	ass.Equal(t, "[b..c]", fmt.Sprint(words))
+}
+
+func TestPersistentQueues(t *tes.T) {
+	var directory = t.TempDir()
+	var queue = fra.PersistentQueueWithCapacity[string](directory, 4)
+	ass.Equal(t, directory, queue.GetDirectory())
+	ass.Equal(t, 4, int(queue.GetCapacity()))
+	queue.AddValue("alpha")
+	queue.AddValue("beta")
+	queue.AddValue("gamma")
+	ass.Equal(t, []string{"alpha", "beta", "gamma"}, queue.AsArray())
+	var first, receipt, ok = queue.RemoveFirstWithReceipt()
+	ass.True(t, ok)
+	ass.Equal(t, "alpha", first)
+	var second, _, _ = queue.RemoveFirstWithReceipt()
+	ass.Equal(t, "beta", second)
+	ass.Equal(t, 2, int(queue.GetUnacknowledged()))
+	ass.True(t, queue.Acknowledge(receipt))
+	ass.False(t, queue.Acknowledge(receipt))
+	ass.Equal(t, 1, int(queue.GetUnacknowledged()))
+
+	// Only one queue at a time may use the directory.
+	ass.PanicsWithValue(t, "The persistent queue in "+directory+" is already open.", func() {
+		fra.PersistentQueueWithCapacity[string](directory, 4)
+	})
+
+	// Reopening the queue replays the values that have not been acknowledged.
+	queue.Close()
+	ass.True(t, queue.IsClosed())
+	ass.False(t, queue.Acknowledge(1))
+	var reopened = fra.PersistentQueueWithCapacity[string](directory, 4)
+	ass.Equal(t, []string{"beta", "gamma"}, reopened.AsArray())
+	reopened.AddValue("delta")
+	first, _ = reopened.RemoveFirst()
+	ass.Equal(t, "beta", first)
+	ass.Equal(t, 0, int(reopened.GetUnacknowledged()))
+	ass.Equal(t, 2, int(reopened.GetSize()))
+	reopened.CloseChannel()
+	var values []string
+	for {
+		var value, ok = reopened.RemoveFirst()
+		if !ok {
+			break
+		}
+		values = append(values, value)
+	}
+	ass.Equal(t, []string{"gamma", "delta"}, values)
+	reopened.Close()
+	reopened = fra.PersistentQueueWithCapacity[string](directory, 4)
+	ass.True(t, reopened.IsEmpty())
+	reopened.Close()
+}
+
+func TestPersistentQueueSegments(t *tes.T) {
+	var directory = t.TempDir()
+	var queue = fra.PersistentQueue[string](directory)
+	var large = str.Repeat("x", 5<<20) // Each large value fills a segment.
+	queue.AddValue("small")
+	queue.AddValue(large)
+	var _, _, _ = queue.RemoveFirstWithReceipt()
+	var _, receipt, _ = queue.RemoveFirstWithReceipt()
+	queue.Acknowledge(receipt)
+
+	// The second segment holds the acknowledgement of a value in the first
+	// segment so it is kept even after its own value is acknowledged.
+	queue.AddValue(large)
+	_, receipt, _ = queue.RemoveFirstWithReceipt()
+	queue.Acknowledge(receipt)
+	var segments, _ = pat.Glob(pat.Join(directory, "*.log"))
+	ass.Equal(t, 3, len(segments))
+	queue.Close()
+	queue = fra.PersistentQueue[string](directory)
+	ass.Equal(t, []string{"small"}, queue.AsArray())
+
+	// Once the first segment is acknowledged the others are deleted too.
+	var small, _ = queue.RemoveFirst()
+	ass.Equal(t, "small", small)
+	segments, _ = pat.Glob(pat.Join(directory, "*.log"))
+	ass.Equal(t, 1, len(segments))
+	queue.Close()
+	queue = fra.PersistentQueue[string](directory)
+	ass.True(t, queue.IsEmpty())
+	queue.Close()
+}
+
+func TestPersistentQueueRecovery(t *tes.T) {
+	var directory = t.TempDir()
+	var encoder = func(value int) []byte {
+		return []byte(fmt.Sprint(value))
+	}
+	var decoder = func(bytes []byte) int {
+		var value int
+		fmt.Sscan(string(bytes), &value)
+		return value
+	}
+	var queue = fra.PersistentQueueWithCodec(directory, 0, encoder, decoder)
+	ass.Equal(t, 16, int(queue.GetCapacity()))
+	for value := range 10 {
+		queue.AddValue(value)
+	}
+	for range 8 {
+		var _, receipt, _ = queue.RemoveFirstWithReceipt()
+		queue.Acknowledge(receipt)
+	}
+	queue.Close()
+
+	// A partially written record is discarded.
+	var segments, _ = pat.Glob(pat.Join(directory, "*.log"))
+	ass.Equal(t, 1, len(segments))
+	var file, _ = osx.OpenFile(segments[0], osx.O_APPEND|osx.O_WRONLY, 0o644)
+	file.Write([]byte{1, 2, 3})
+	file.Close()
+	queue = fra.PersistentQueueWithCodec(directory, 0, encoder, decoder)
+	ass.Equal(t, []int{8, 9}, queue.AsArray())
+	queue.AddValue(10)
+
+	// Compaction leaves a single segment containing the remaining values.
+	queue.Compact()
+	segments, _ = pat.Glob(pat.Join(directory, "*.log"))
+	ass.Equal(t, 1, len(segments))
+	queue.Close()
+	queue = fra.PersistentQueueWithCodec(directory, 0, encoder, decoder)
+	ass.Equal(t, []int{8, 9, 10}, queue.AsArray())
+	queue.RemoveAll()
+	queue.Close()
+	queue = fra.PersistentQueueWithCodec(directory, 0, encoder, decoder)
+	ass.True(t, queue.IsEmpty())
+	queue.Close()
+}
+
+func TestQueueMetrics(t *tes.T) {
+	var queue = fra.QueueWithCapacity[int](2)
+	queue.SetName("metered")
+	ass.Equal(t, "metered", queue.GetName())
+	queue.AddValue(1)
+	queue.AddValue(2)
+
+	// The producer blocks until a value is removed from the full queue.
+	var group = new(syn.WaitGroup)
+	group.Go(func() {
+		queue.AddValue(3)
+	})
+	for queue.GetMetrics().GetProducerBlocked() == 0 {
+		tim.Sleep(tim.Millisecond) // Wait for the producer to block.
+	}
+	queue.RemoveFirst()
+	group.Wait()
+	var metrics = queue.GetMetrics()
+	ass.Equal(t, "metered", metrics.GetName())
+	ass.Equal(t, 2, int(metrics.GetCapacity()))
+	ass.Equal(t, 3, int(metrics.GetEnqueued()))
+	ass.Equal(t, 1, int(metrics.GetDequeued()))
+	ass.Equal(t, 2, int(metrics.GetDepth()))
+	ass.Equal(t, 2, int(metrics.GetHighWaterMark()))
+	ass.True(t, metrics.GetProducerBlocked() > 0)
+	ass.Equal(t, metrics.GetProducerBlocked(), queue.GetMetrics().GetProducerBlocked())
+	ass.Equal(t, tim.Duration(0), metrics.GetConsumerBlocked())
+
+	// The time spent waiting in a selection is included.
+	var empty = fra.QueueWithCapacity[int](2)
+	group.Go(func() {
+		var _, value, _ = fra.QueueClass[int]().Select(fra.ListFromArray([]fra.QueueLike[int]{empty}))
+		ass.Equal(t, 4, value)
+	})
+	for empty.GetMetrics().GetConsumerBlocked() == 0 {
+		tim.Sleep(tim.Millisecond) // Wait for the selection to block.
+	}
+	empty.AddValue(4)
+	group.Wait()
+	ass.Equal(t, 1, int(empty.GetMetrics().GetDequeued()))
+
+	// The registry contains each live queue.
+	var found bool
+	var iterator = fra.MetricsClass().Snapshot().GetIterator()
+	for iterator.HasNext() {
+		if iterator.GetNext().GetName() == "metered" {
+			found = true
+		}
+	}
+	ass.True(t, found)
+	var exported = fra.MetricsClass().Exporter().String()
+	ass.Contains(t, exported, `{"name":"metered","capacity":2,"enqueued":3,"dequeued":1,"depth":2,"highWaterMark":2,`)
+	queue.RemoveAll()
+
+	// Decoded queues are registered too.
+	var buffer byt.Buffer
+	fra.Encoder(&buffer).EncodeValue(queue)
+	var decoded fra.QueueLike[int]
+	ass.Nil(t, fra.Decoder(&buffer).DecodeValue(&decoded))
+	decoded.SetName("decoded")
+	ass.Contains(t, fra.MetricsClass().Exporter().String(), `{"name":"decoded","capacity":2,`)
+
+	// The metrics of a decoded queue account for its values and it is only
+	// registered once no matter how many times it is decoded.
+	queue = fra.QueueFromArray([]int{1, 2, 3})
+	fra.Encoder(&buffer).EncodeValue(queue)
+	queue.EncodeValues(fra.Encoder(&buffer))
+	ass.Nil(t, fra.Decoder(&buffer).DecodeValue(&decoded))
+	metrics = decoded.GetMetrics()
+	ass.Equal(t, 3, int(metrics.GetEnqueued()))
+	ass.Equal(t, 0, int(metrics.GetDequeued()))
+	ass.Equal(t, 3, int(metrics.GetHighWaterMark()))
+	decoded.RemoveFirst()
+	decoded.SetName("redecoded")
+	decoded.DecodeValues(fra.Decoder(&buffer))
+	metrics = decoded.GetMetrics()
+	ass.Equal(t, 6, int(metrics.GetEnqueued()))
+	ass.Equal(t, 3, int(metrics.GetDequeued()))
+	ass.Equal(t, 3, int(metrics.GetDepth()))
+	var count int
+	iterator = fra.MetricsClass().Snapshot().GetIterator()
+	for iterator.HasNext() {
+		if iterator.GetNext().GetName() == "redecoded" {
+			count++
+		}
+	}
+	ass.Equal(t, 1, count)
+}
+
+func TestQueueSelect(t *tes.T) {
+	var class = fra.QueueClass[int]()
+	var first = fra.QueueWithCapacity[int](100)
+	var second = fra.QueueWithCapacity[int](100)
+	var queues = fra.ListFromArray([]fra.QueueLike[int]{first, second})
+	for value := range 100 {
+		first.AddValue(value)
+		second.AddValue(-value)
+	}
+
+	// Both queues are chosen when both have values.
+	var counts = make([]int, 2)
+	for range 100 {
+		var index, value, ok = class.Select(queues)
+		ass.True(t, ok)
+		if index == 0 {
+			ass.True(t, value >= 0)
+		} else {
+			ass.True(t, value <= 0)
+		}
+		counts[index]++
+	}
+	ass.True(t, counts[0] > 0 && counts[1] > 0)
+
+	// Closed queues are skipped once they are empty.
+	first.RemoveAll()
+	first.CloseChannel()
+	second.CloseChannel()
+	var index, value, ok = class.Select(queues)
+	ass.Equal(t, 1, index)
+	ass.True(t, ok)
+	ass.Equal(t, -counts[1], value)
+	for range 100 - counts[1] - 1 {
+		class.Select(queues)
+	}
+	index, _, ok = class.Select(queues)
+	ass.Equal(t, -1, index)
+	ass.False(t, ok)
+
+	// A selection can be abandoned by canceling its context.
+	var empty = fra.ListFromArray([]fra.QueueLike[int]{fra.Queue[int]()})
+	var context, cancel = ctx.WithTimeout(ctx.Background(), 10*tim.Millisecond)
+	defer cancel()
+	index, _, ok = class.SelectWithContext(context, empty)
+	ass.Equal(t, -1, index)
+	ass.False(t, ok)
+	ass.Equal(t, ctx.DeadlineExceeded, context.Err())
+}
+
+func TestQueueLifecycle(t *tes.T) {
+	var group = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// A producer that is blocked on a full queue is released when it closes.
+	var queue = fra.QueueWithCapacity[int](2)
+	ass.True(t, queue.AddValue(1))
+	ass.True(t, queue.AddValue(2))
+	ass.False(t, queue.IsClosed())
+	var released = make(chan bool)
+	group.Go(func() {
+		released <- queue.AddValue(3)
+	})
+	tim.Sleep(10 * tim.Millisecond)
+	queue.CloseChannel()
+	ass.False(t, <-released)
+	ass.True(t, queue.IsClosed())
+	queue.CloseChannel() // Closing the queue again has no effect.
+
+	// Values cannot be added to a closed queue and removing them does not
+	// reopen it.
+	ass.False(t, queue.AddValue(4))
+	queue.RemoveAll()
+	ass.True(t, queue.IsClosed())
+	ass.True(t, queue.IsEmpty())
+	ass.False(t, queue.AddValue(5))
+	var _, ok = queue.RemoveFirst()
+	ass.False(t, ok)
+
+	// The remaining values can be drained from an open or closed queue.
+	queue = fra.QueueWithCapacity[int](10)
+	for value := range 5 {
+		queue.AddValue(value)
+	}
+	ass.Equal(t, []int{0, 1, 2, 3, 4}, queue.Drain().AsArray())
+	ass.True(t, queue.IsEmpty())
+	queue.AddValue(5)
+	queue.AddValue(6)
+	queue.CloseChannel()
+	ass.Equal(t, []int{5, 6}, queue.Drain().AsArray())
+	ass.Equal(t, 0, int(queue.Drain().GetSize()))
+
+	// Closing and waiting blocks until the consumers have emptied the queue.
+	queue = fra.QueueWithCapacity[int](100)
+	for value := range 100 {
+		queue.AddValue(value)
+	}
+	var consumed = make([]int, 4)
+	for index := range consumed {
+		group.Go(func() {
+			for {
+				var _, ok = queue.RemoveFirst()
+				if !ok {
+					return
+				}
+				consumed[index]++
+			}
+		})
+	}
+	queue.CloseAndWait()
+	ass.True(t, queue.IsEmpty())
+	group.Wait()
+	ass.Equal(t, 100, consumed[0]+consumed[1]+consumed[2]+consumed[3])
+}
+
+func TestPersistentQueueLifecycle(t *tes.T) {
+	var directory = t.TempDir()
+	var queue = fra.PersistentQueueWithCapacity[string](directory, 4)
+	ass.True(t, queue.AddValue("alpha"))
+	ass.True(t, queue.AddValue("beta"))
+	ass.True(t, queue.AddValue("gamma"))
+	queue.CloseChannel()
+	ass.True(t, queue.IsClosed())
+	ass.False(t, queue.AddValue("delta"))
+
+	// Values that are removed with a receipt must still be acknowledged.
+	var _, receipt, _ = queue.RemoveFirstWithReceipt()
+	ass.Equal(t, 1, int(queue.GetUnacknowledged()))
+	ass.Equal(t, []string{"beta", "gamma"}, queue.Drain().AsArray())
+	ass.Equal(t, 1, int(queue.GetUnacknowledged()))
+	queue.Close()
+	ass.False(t, queue.Acknowledge(receipt))
+	var reopened = fra.PersistentQueueWithCapacity[string](directory, 4)
+	ass.Equal(t, []string{"alpha"}, reopened.AsArray())
+
+	// Removed values are discarded.
+	reopened.RemoveAll()
+	ass.False(t, reopened.IsClosed())
+	reopened.CloseAndWait()
+	reopened.Close()
+	reopened = fra.PersistentQueueWithCapacity[string](directory, 4)
+	ass.True(t, reopened.IsEmpty())
+	reopened.Close()
+}
+
+func TestQueueSnapshots(t *tes.T) {
+	var group = new(syn.WaitGroup)
+	var queue = fra.QueueWithCapacity[int](8)
+
+	// Each snapshot contains consecutive values while values are being added
+	// and removed concurrently.
+	group.Go(func() {
+		for value := range 1000 {
+			queue.AddValue(value)
+		}
+		queue.CloseChannel()
+	})
+	group.Go(func() {
+		for {
+			var _, ok = queue.RemoveFirst()
+			if !ok {
+				return
+			}
+		}
+	})
+	for !queue.IsClosed() {
+		var array = queue.AsArray()
+		ass.True(t, len(array) <= 8)
+		for index := 1; index < len(array); index++ {
+			ass.Equal(t, array[index-1]+1, array[index])
+		}
+		var iterator = queue.GetIterator()
+		ass.True(t, iterator.GetSize() <= 8)
+	}
+	group.Wait()
+
+	// Producers that are blocked when the queue is emptied add their values
+	// to the emptied queue.
+	queue = fra.QueueWithCapacity[int](2)
+	queue.AddValue(1)
+	queue.AddValue(2)
+	for value := 3; value < 6; value++ {
+		group.Go(func() {
+			queue.AddValue(value)
+		})
+	}
+	tim.Sleep(10 * tim.Millisecond)
+	queue.RemoveAll()
+	var values []int
+	for range 3 {
+		var value, _ = queue.RemoveFirst()
+		values = append(values, value)
+	}
+	group.Wait()
+	sli.Sort(values)
+	ass.Equal(t, []int{3, 4, 5}, values)
+	ass.True(t, queue.IsEmpty())
+	ass.Equal(t, 0, len(queue.AsArray()))
+}
+
+type manualClock struct {
+	now    tim.Time
+	sleeps []tim.Duration
+}
+
+func (v *manualClock) Now() tim.Time {
+	return v.now
+}
+
+func (v *manualClock) Sleep(duration tim.Duration) {
+	v.sleeps = append(v.sleeps, duration)
+	v.now = v.now.Add(duration)
+}
+
+func TestQueueThrottle(t *tes.T) {
+	var group = new(syn.WaitGroup)
+	defer group.Wait()
+	var class = fra.QueueClass[int]()
+
+	// The burst is released at once and the rest at the specified rate.
+	var clock = &manualClock{now: tim.Unix(0, 0)}
+	var input = fra.QueueWithCapacity[int](8)
+	var output = class.ThrottleWithClock(group, input, 10, 2, clock)
+	ass.Equal(t, 8, int(output.GetCapacity()))
+	for value := range 5 {
+		input.AddValue(value)
+	}
+	input.CloseChannel()
+	var values []int
+	for {
+		var value, ok = output.RemoveFirst()
+		if !ok {
+			break
+		}
+		values = append(values, value)
+	}
+	ass.Equal(t, []int{0, 1, 2, 3, 4}, values)
+	var interval = 100 * tim.Millisecond
+	ass.Equal(t, []tim.Duration{interval, interval, interval}, clock.sleeps)
+
+	// Tokens accumulate while the input queue is idle, up to the burst size.
+	clock = &manualClock{now: tim.Unix(0, 0)}
+	input = fra.QueueWithCapacity[int](8)
+	output = class.ThrottleWithClock(group, input, 10, 1, clock)
+	input.AddValue(1)
+	output.RemoveFirst()
+	input.AddValue(2)
+	output.RemoveFirst()
+	ass.Equal(t, []tim.Duration{interval}, clock.sleeps)
+	clock.now = clock.now.Add(tim.Second)
+	input.AddValue(3)
+	output.RemoveFirst()
+	input.AddValue(4)
+	output.RemoveFirst()
+	ass.Equal(t, []tim.Duration{interval, interval}, clock.sleeps)
+	input.CloseChannel()
+
+	// The system clock is used by default.
+	var start = tim.Now()
+	input = fra.QueueWithCapacity[int](8)
+	output = class.Throttle(group, input, 100, 1)
+	for value := range 3 {
+		input.AddValue(value)
+	}
+	input.CloseChannel()
+	for range 3 {
+		output.RemoveFirst()
+	}
+	ass.True(t, tim.Since(start) >= 20*tim.Millisecond)
+
+	// An infinite rate would never pace the values.
+	ass.PanicsWithValue(t, "The rate for a throttle must be a finite number greater than zero.", func() {
+		class.Throttle(group, input, mat.Inf(1), 1)
+	})
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The rate for a throttle must be a finite number greater than zero.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	class.Throttle(group, input, 0, 1)
+}
+
 func TestCatalogsWithMerge(t *tes.T) {
 	var collator = fra.Collator[fra.CatalogLike[string, int]]()
 	var association1 = fra.Association("foo", 1)
@@ -1412,6 +3084,68 @@ func TestListsWithStrings(t *tes.T) {
 	ass.Equal(t, "bar", list.GetValue(1))                              // ["bar", "foo"]
 }
 
+func TestListsWithBisection(t *tes.T) {
+	var ranker = fra.Collator[int]().RankValues
+	var list = fra.List[int]()
+	for _, value := range []int{5, 3, 8, 3, 1, 9, 3} {
+		list.InsertSorted(value, ranker)
+	}
+	ass.Equal(t, []int{1, 3, 3, 3, 5, 8, 9}, list.AsArray())
+	var index, found = list.SearchValue(3, ranker)
+	ass.True(t, found)
+	ass.Equal(t, 2, index)
+	ass.Equal(t, 1, int(list.LowerBound(3, ranker)))
+	ass.Equal(t, 4, int(list.UpperBound(3, ranker)))
+	index, found = list.SearchValue(6, ranker)
+	ass.False(t, found)
+	ass.Equal(t, 5, index)
+	list.InsertValue(uint(index), 6)
+	ass.Equal(t, []int{1, 3, 3, 3, 5, 6, 8, 9}, list.AsArray())
+	ass.Equal(t, 0, int(list.LowerBound(0, ranker)))
+	ass.Equal(t, 8, int(list.UpperBound(10, ranker)))
+
+	// The values retain the order in which they were inserted.
+	var pairs = fra.List[Pair]()
+	pairs.InsertSorted(Pair{Key: 2, Order: 1}, rankPairs)
+	pairs.InsertSorted(Pair{Key: 1, Order: 2}, rankPairs)
+	pairs.InsertSorted(Pair{Key: 2, Order: 3}, rankPairs)
+	ass.Equal(t, []Pair{{1, 2}, {2, 1}, {2, 3}}, pairs.AsArray())
+}
+
+type EmployeeCollator struct {
+	fra.CollatorLike[*Employee]
+}
+
+func (v EmployeeCollator) CompareValues(first, second *Employee) bool {
+	return first.Name == second.Name
+}
+
+func TestListsWithCollator(t *tes.T) {
+	var collator = EmployeeCollator{fra.Collator[*Employee]()}
+	var list = fra.ListWithCollator[*Employee](collator)
+	ass.Equal(t, collator, list.GetCollator())
+	list.AppendValue(&Employee{Name: "Alice", Salary: 200})
+	list.AppendValue(&Employee{Name: "Bob", Salary: 100})
+	var bob = &Employee{Name: "Bob"}
+	ass.Equal(t, 2, list.GetIndex(bob))
+	ass.True(t, list.ContainsValue(bob))
+	ass.False(t, list.ContainsValue(&Employee{Name: "Carol"}))
+	ass.Equal(t, collator, list.GetValues(1, 1).(fra.ListLike[*Employee]).GetCollator())
+	var concatenated = fra.ListClass[*Employee]().Concatenate(list, fra.List[*Employee]())
+	ass.Equal(t, collator, concatenated.GetCollator())
+	ass.Equal(t, 2, int(concatenated.GetSize()))
+
+	// The default collator compares the values deeply.
+	ass.Equal(t, 0, fra.ListFromArray[*Employee](list.AsArray()).GetIndex(bob))
+
+	// Catalogs locate their associations by identity.
+	var catalog = fra.Catalog[string, []int]()
+	catalog.SetValue("alpha", []int{1})
+	catalog.SetValue("beta", []int{1})
+	catalog.RemoveValue("beta")
+	ass.Equal(t, []string{"alpha"}, catalog.GetKeys().AsArray())
+}
+
 func TestListsWithTildes(t *tes.T) {
 	var array = fra.ListFromArray([]Integer{3, 1, 4, 5, 9, 2})
 	var list = fra.ListFromSequence(array)
@@ -1526,6 +3260,49 @@ func TestQueueWithFork(t *tes.T) {
 	input.CloseChannel()
 }
 
+func TestQueuePipelinesWithClosedOutputs(t *tes.T) {
+	var class = fra.QueueClass[int]()
+	var clock = &manualClock{now: tim.Unix(0, 0)}
+	var group = new(syn.WaitGroup)
+	var input = fra.QueueWithCapacity[int](8)
+	var output = class.ThrottleWithClock(group, input, 10, 1, clock)
+	output.CloseChannel()
+	input.AddValue(1)
+	input.AddValue(2)
+	group.Wait() // The throttle stops once its output queue is closed.
+	ass.Equal(t, 1, int(input.GetSize()))
+
+	input = fra.QueueWithCapacity[int](8)
+	var outputs = class.Fork(group, input, 2)
+	outputs.AsArray()[1].CloseChannel()
+	input.AddValue(1)
+	input.AddValue(2)
+	group.Wait() // The fork stops once any of its output queues is closed.
+	ass.Equal(t, 1, int(input.GetSize()))
+	var value, ok = outputs.AsArray()[0].RemoveFirst()
+	ass.True(t, ok)
+	ass.Equal(t, 1, value)
+	_, ok = outputs.AsArray()[0].RemoveFirst()
+	ass.False(t, ok)
+
+	input = fra.QueueWithCapacity[int](8)
+	outputs = class.Split(group, input, 2)
+	outputs.AsArray()[0].CloseChannel()
+	input.AddValue(1)
+	input.AddValue(2)
+	group.Wait() // The split stops once any of its output queues is closed.
+	ass.Equal(t, 1, int(input.GetSize()))
+	ass.True(t, outputs.AsArray()[1].IsEmpty())
+
+	input = fra.QueueWithCapacity[int](8)
+	output = class.Join(group, fra.ListFromArray([]fra.QueueLike[int]{input}))
+	output.CloseChannel()
+	input.AddValue(1)
+	input.AddValue(2)
+	group.Wait() // The join stops once its output queue is closed.
+	ass.Equal(t, 1, int(input.GetSize()))
+}
+
 func TestQueueWithInvalidFanOut(t *tes.T) {
 	// Create a wait group for synchronization.
 	var group fra.Synchronized = new(syn.WaitGroup)
@@ -1894,6 +3671,157 @@ func TestIntervalConstructors(t *tes.T) {
 	)
 }
 
+func TestIntervalsWithFactory(t *tes.T) {
+	var glyphs = fra.IntervalWithFactory[Glyph](
+		fra.Inclusive,
+		Glyph(65),
+		Glyph(70),
+		fra.Exclusive,
+		func(integer int) Glyph { return Glyph(integer) },
+	)
+	ass.Equal(t, 5, int(glyphs.GetSize()))
+	ass.Equal(t, Glyph('A'), glyphs.GetValue(1))
+	ass.Equal(t, Glyph('E'), glyphs.GetValue(-1))
+	ass.Equal(t, []Glyph{'A', 'B', 'C', 'D', 'E'}, glyphs.AsArray())
+	var values = glyphs.GetValues(2, 3)
+	ass.Equal(t, []Glyph{'B', 'C'}, values.AsArray())
+	var iterator = glyphs.GetIterator()
+	ass.Equal(t, Glyph('A'), iterator.GetNext())
+	ass.Equal(t, Glyph('B'), iterator.GetNext())
+}
+
+func TestIntervalsWithLargeDomains(t *tes.T) {
+	var factory = func(integer int) Glyph { return Glyph(integer) }
+	var glyphs = fra.IntervalWithFactory[Glyph](
+		fra.Inclusive,
+		Glyph(0),
+		Glyph(1000),
+		fra.Exclusive,
+		factory,
+	)
+	var list = fra.ListFromSequence[Glyph](glyphs)
+	ass.Equal(t, 1000, int(list.GetSize()))
+	ass.Equal(t, Glyph(999), list.GetValue(-1))
+	ass.Equal(t, 1, glyphs.GetIndex(Glyph(0)))
+	ass.Equal(t, 1000, glyphs.GetIndex(Glyph(999)))
+	ass.Equal(t, 0, glyphs.GetIndex(Glyph(1000)))
+
+	glyphs = fra.IntervalWithFactory[Glyph](
+		fra.Inclusive,
+		Glyph(0),
+		Glyph(mat.MaxInt32),
+		fra.Inclusive,
+		factory,
+	)
+	ass.Equal(t, mat.MaxInt32+1, int(glyphs.GetSize()))
+	ass.Equal(t, mat.MaxInt32, glyphs.GetIndex(Glyph(mat.MaxInt32-1)))
+	var values = glyphs.GetValues(-3, -2)
+	ass.Equal(t, []Glyph{mat.MaxInt32 - 2, mat.MaxInt32 - 1}, values.AsArray())
+	values = glyphs.GetValues(-1, -1)
+	ass.Equal(t, []Glyph{mat.MaxInt32}, values.AsArray())
+	var iterator = glyphs.GetIterator()
+	iterator.ToEnd()
+	ass.Equal(t, Glyph(mat.MaxInt32), iterator.GetPrevious())
+
+	glyphs.SetLimit(256)
+	ass.True(t, glyphs.GetLimit() == 256)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(
+				t,
+				"The size of the interval 2147483648 exceeds its materialization limit: 256",
+				e,
+			)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	glyphs.AsArray() // This should panic.
+}
+
+func TestIntervalsWithExtremeEndpoints(t *tes.T) {
+	var factory = func(integer int) Offset { return Offset(integer) }
+	var offsets = fra.IntervalWithFactory[Offset](
+		fra.Inclusive,
+		Offset(mat.MinInt),
+		Offset(-2),
+		fra.Inclusive,
+		factory,
+	)
+	ass.Equal(t, uint(mat.MaxInt), offsets.GetSize())
+	ass.Equal(t, Offset(mat.MinInt), offsets.GetValue(1))
+	ass.Equal(t, Offset(-2), offsets.GetValue(-1))
+	ass.Equal(t, Offset(mat.MinInt), offsets.GetValue(mat.MinInt+1))
+	ass.Equal(t, mat.MaxInt, offsets.GetIndex(Offset(-2)))
+	ass.Equal(t, 0, offsets.GetIndex(Offset(-1)))
+	offsets = fra.IntervalWithFactory[Offset](
+		fra.Exclusive,
+		Offset(1),
+		Offset(mat.MaxInt),
+		fra.Inclusive,
+		factory,
+	)
+	ass.Equal(t, uint(mat.MaxInt-1), offsets.GetSize())
+	ass.Equal(t, Offset(mat.MaxInt), offsets.GetValue(-1))
+	ass.Equal(t, mat.MaxInt-1, offsets.GetIndex(Offset(mat.MaxInt)))
+
+	// Intervals that would have more elements than a positive index can reach
+	// are rejected rather than overflowing.
+	ass.PanicsWithValue(
+		t,
+		"The effective size of the interval [-9223372036854775808..9223372036854775807] exceeds the maximum size: 9223372036854775807",
+		func() {
+			fra.IntervalWithFactory[Offset](fra.Inclusive, Offset(mat.MinInt), Offset(mat.MaxInt), fra.Inclusive, factory)
+		},
+	)
+	ass.Panics(t, func() {
+		fra.IntervalWithFactory[Offset](fra.Exclusive, Offset(mat.MinInt), Offset(mat.MaxInt), fra.Exclusive, factory)
+	})
+	ass.Panics(t, func() {
+		fra.IntervalWithFactory[Offset](fra.Inclusive, Offset(0), Offset(mat.MaxInt), fra.Inclusive, factory)
+	})
+	ass.PanicsWithValue(
+		t,
+		"The effective size of an interval must be greater than zero: 0.",
+		func() {
+			fra.IntervalWithFactory[Offset](fra.Exclusive, Offset(mat.MaxInt), Offset(mat.MaxInt), fra.Inclusive, factory)
+		},
+	)
+	ass.PanicsWithValue(
+		t,
+		"The effective size of an interval must be greater than zero: -1.",
+		func() {
+			fra.IntervalWithFactory[Offset](fra.Exclusive, Offset(mat.MinInt), Offset(mat.MinInt), fra.Exclusive, factory)
+		},
+	)
+}
+
+func TestIntervalWithoutFactory(t *tes.T) {
+	var glyphs = fra.Interval[Glyph](
+		fra.Inclusive,
+		Glyph(65),
+		Glyph(70),
+		fra.Exclusive,
+	)
+	ass.True(t, glyphs.ContainsValue(Glyph('C')))
+	ass.Equal(t, Glyph('A'), glyphs.GetValue(1))
+	ass.Equal(t, Glyph('E'), glyphs.GetValue(-1))
+	ass.Equal(t, 3, glyphs.GetIndex(Glyph('C')))
+	ass.Equal(t, []Glyph{'B', 'C', 'D'}, glyphs.GetValues(2, 4).AsArray())
+	ass.PanicsWithValue(
+		t,
+		"The elements of an interval of type module_test.Offset cannot be constructed without a factory function.",
+		func() {
+			fra.Interval[Offset](
+				fra.Inclusive,
+				Offset(1),
+				Offset(5),
+				fra.Inclusive,
+			) // This should panic.
+		},
+	)
+}
+
 func TestSpectrumConstructors(t *tes.T) {
 	var words = fra.Spectrum[Word](
 		fra.Inclusive,
@@ -1932,6 +3860,59 @@ func TestSpectrumConstructors(t *tes.T) {
 	)
 }
 
+func TestSpectrumsWithSuccessors(t *tes.T) {
+	var letters = fra.Spectrum[Letter](
+		fra.Exclusive,
+		Letter("a"),
+		Letter("f"),
+		fra.Inclusive,
+	)
+	var sequence, ok = letters.AsSequence(0)
+	ass.True(t, ok)
+	ass.False(t, sequence.IsEmpty())
+	ass.Equal(t, 5, int(sequence.GetSize()))
+	ass.Equal(t, []Letter{"b", "c", "d", "e", "f"}, sequence.AsArray())
+	var list = fra.ListFromSequence[Letter](sequence)
+	ass.Equal(t, Letter("f"), list.GetValue(-1))
+
+	letters.SetRight(fra.Exclusive)
+	sequence, ok = letters.AsSequence(4)
+	ass.True(t, ok)
+	var iterator = sequence.GetIterator()
+	ass.Equal(t, Letter("b"), iterator.GetNext())
+	ass.Equal(t, Letter("c"), iterator.GetNext())
+	ass.Equal(t, Letter("c"), iterator.GetPrevious())
+	iterator.ToEnd()
+	ass.True(t, iterator.GetSlot() == 4)
+	ass.Equal(t, Letter("e"), iterator.GetPrevious())
+
+	// A sequence that exceeds the limit is not returned.
+	sequence, ok = letters.AsSequence(3)
+	ass.False(t, ok)
+	ass.Nil(t, sequence)
+
+	// An unbounded sequence is only generated as it is traversed.
+	letters.SetMaximum(Letter("zzzzzzzzzzzzzzzz"))
+	sequence, ok = letters.AsSequence(0)
+	ass.True(t, ok)
+	iterator = sequence.GetIterator()
+	iterator.SetSlot(3)
+	ass.Equal(t, Letter("e"), iterator.GetNext())
+}
+
+func TestSpectrumWithoutSuccessors(t *tes.T) {
+	var words = fra.Spectrum[Word](
+		fra.Inclusive,
+		Word("alpha"),
+		Word("beta"),
+		fra.Inclusive,
+	)
+	ass.True(t, words.ContainsValue(Word("apple")))
+	ass.PanicsWithValue(t, "The elements of the spectrum [alpha..beta] are not successive so it cannot be traversed.", func() {
+		words.AsSequence(0)
+	})
+}
+
 func TestContinuumConstructors(t *tes.T) {
 	var numbers = fra.Continuum[Number](
 		fra.Exclusive,
@@ -1964,6 +3945,153 @@ func TestContinuumConstructors(t *tes.T) {
 		fra.Inclusive,
 	)
 	ass.Equal(t, "[0..1]", fmt.Sprintf("%v", numbers))
+
+	// An endpoint that is defined cannot be NaN.
+	ass.PanicsWithValue(t, "The maximum NaN in a continuum cannot be NaN.", func() {
+		fra.Continuum[Measure](fra.Inclusive, Measure(0), Measure(mat.NaN()), fra.Inclusive)
+	})
+	ass.PanicsWithValue(t, "The minimum NaN in a continuum cannot be NaN.", func() {
+		fra.Continuum[Measure](fra.Inclusive, Measure(mat.NaN()), Measure(0), fra.Inclusive)
+	})
+}
+
+func TestMomentsAndDurations(t *tes.T) {
+	var start = fra.MomentFromString("2025-03-01T22:30:00Z")
+	var end = fra.Moment(tim.Date(2025, 3, 3, 1, 15, 0, 0, tim.UTC))
+	ass.Equal(t, "2025-03-01T22:30:00.000Z", start.AsSource())
+	ass.Equal(t, "2025-03-03T01:15:00.000Z", end.AsSource())
+	ass.True(t, start.IsBefore(end))
+	ass.Equal(t, start, fra.MomentFromString(start.AsSource()))
+
+	var window = fra.Continuum[fra.MomentLike](
+		fra.Inclusive,
+		start,
+		end,
+		fra.Exclusive,
+	)
+	ass.Equal(
+		t,
+		"[2025-03-01T22:30:00.000Z..2025-03-03T01:15:00.000Z)",
+		fmt.Sprintf("%v", window),
+	)
+	ass.True(t, window.ContainsValue(fra.MomentFromString("2025-03-02")))
+	ass.False(t, window.ContainsValue(fra.MomentFromString("2025-03-04")))
+
+	var days = fra.MomentClass().Days(start, end)
+	ass.Equal(t, "[2025-03-01..2025-03-03]", fmt.Sprintf("%v", days))
+	ass.Equal(t, 3, int(days.GetSize()))
+	ass.Equal(t, "2025-03-02", days.GetValue(2).AsSource())
+	ass.Equal(t, 2, days.GetIndex(days.GetValue(2)))
+	var day = fra.MomentFromString("2025-03-02T12:00:00Z").AsDay()
+	ass.True(t, days.ContainsValue(day))
+	ass.Equal(t, 2, days.GetIndex(day))
+	ass.Equal(t, "2025-03-02T00:00:00.000Z", day.AsMoment().AsSource())
+	ass.False(t, days.ContainsValue(fra.MomentFromString("2025-03-04").AsDay()))
+	var hours = fra.MomentClass().Hours(start, end)
+	ass.Equal(t, 28, int(hours.GetSize()))
+	ass.Equal(t, "2025-03-01T22Z", hours.GetValue(1).AsSource())
+	ass.Equal(t, "2025-03-03T01Z", hours.GetValue(-1).AsSource())
+	ass.Equal(t, 3, hours.GetIndex(fra.MomentFromString("2025-03-02T00:59:59Z").AsHour()))
+
+	var duration = fra.DurationClass().Between(start, end)
+	ass.Equal(t, "P1DT2H45M", duration.AsSource())
+	ass.Equal(t, 26*tim.Hour+45*tim.Minute, duration.AsDuration())
+	ass.Equal(t, duration, fra.DurationFromString("P1DT2H45M"))
+	ass.Equal(t, "PT0S", fra.Duration(0).AsSource())
+	ass.Equal(t, "-PT1.5S", fra.DurationFromString("-PT1.5S").AsSource())
+	ass.True(t, fra.DurationFromString("PT90M").IsBefore(duration))
+	ass.Equal(t, "PT1H30M", fra.DurationFromString("PT90M").AsSource())
+	for _, source := range []string{"P2D", "-P1DT0.5S", "P3DT4H5M6.7S", "PT23H59M59.999S"} {
+		ass.Equal(t, source, fra.DurationFromString(source).AsSource())
+	}
+}
+
+func TestInvalidDuration(t *tes.T) {
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(
+				t,
+				"An invalid duration was passed to the constructor: P1DT",
+				e,
+			)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.DurationFromString("P1DT")
+}
+
+func TestIntervalTrees(t *tes.T) {
+	type Window = fra.ContinuumLike[Number]
+	var tree = fra.IntervalTree[Window, Number, string]()
+	ass.True(t, tree.IsEmpty())
+	var morning = fra.Continuum[Number](fra.Inclusive, 8, 12, fra.Exclusive)
+	var lunch = fra.Continuum[Number](fra.Inclusive, 12, 13, fra.Inclusive)
+	var afternoon = fra.Continuum[Number](fra.Exclusive, 13, 17, fra.Inclusive)
+	var workday = fra.Continuum[Number](fra.Inclusive, 8, 17, fra.Inclusive)
+	var evening = fra.Continuum[Number](fra.Inclusive, 17, Number(mat.NaN()), fra.Exclusive)
+	tree.SetValue(afternoon, "afternoon")
+	tree.SetValue(evening, "evening")
+	tree.SetValue(lunch, "lunch")
+	tree.SetValue(workday, "workday")
+	tree.SetValue(morning, "breakfast")
+	tree.SetValue(morning, "morning")
+	ass.True(t, tree.GetSize() == 5)
+	ass.Equal(t, "morning", tree.GetValue(morning))
+
+	var names = func(associations fra.Sequential[fra.AssociationLike[Window, string]]) []string {
+		var result = []string{}
+		var iterator = associations.GetIterator()
+		for iterator.HasNext() {
+			result = append(result, iterator.GetNext().GetValue())
+		}
+		return result
+	}
+	ass.Equal(t, []string{"morning", "workday", "lunch", "afternoon", "evening"}, names(tree))
+	ass.Equal(t, []string{"workday", "lunch"}, names(tree.GetContaining(12)))
+	ass.Equal(t, []string{"workday", "lunch"}, names(tree.GetContaining(13)))
+	ass.Equal(t, []string{"workday", "afternoon", "evening"}, names(tree.GetContaining(17)))
+	ass.Equal(t, []string{"evening"}, names(tree.GetContaining(1000)))
+	ass.Equal(t, []string{}, names(tree.GetContaining(7)))
+	var query = fra.Continuum[Number](fra.Exclusive, 11, 12, fra.Exclusive)
+	ass.Equal(t, []string{"morning", "workday"}, names(tree.GetOverlapping(query)))
+	query = fra.Continuum[Number](fra.Inclusive, Number(mat.NaN()), 8, fra.Inclusive)
+	ass.Equal(t, []string{"morning", "workday"}, names(tree.GetOverlapping(query)))
+
+	ass.Equal(t, "workday", tree.RemoveValue(workday))
+	ass.Equal(t, "", tree.RemoveValue(workday))
+	ass.Equal(t, []string{"lunch"}, names(tree.GetContaining(12.5)))
+	tree.RemoveAll()
+	ass.True(t, tree.GetSize() == 0)
+}
+
+func TestIntervalTreesWithManyRanges(t *tes.T) {
+	type Window = fra.ContinuumLike[Number]
+	var tree = fra.IntervalTree[Window, Number, int]()
+	var windows = []Window{}
+	for i := 0; i < 500; i++ {
+		var minimum = Number((i * 37) % 101)
+		var window = fra.Continuum[Number](
+			fra.Inclusive,
+			minimum,
+			minimum+Number(1+(i*13)%7),
+			fra.Exclusive,
+		)
+		windows = append(windows, window)
+		tree.SetValue(window, i)
+	}
+	for i := 0; i < 500; i += 3 {
+		tree.RemoveValue(windows[i])
+	}
+	for point := Number(-1); point < 110; point += 0.5 {
+		var expected = 0
+		for i, window := range windows {
+			if i%3 != 0 && window.ContainsValue(point) && point != window.GetMaximum() {
+				expected++
+			}
+		}
+		ass.Equal(t, expected, int(tree.GetContaining(point).GetSize()))
+	}
 }
 
 type Glyph rune
@@ -1992,6 +4120,24 @@ func (v Glyph) IsMaximum() bool {
 	return v == mat.MaxInt32
 }
 
+func (v Glyph) GetNext() Glyph {
+	return v + 1
+}
+
+type Offset int
+
+func (v Offset) AsSource() string {
+	return fmt.Sprint(int(v))
+}
+
+func (v Offset) AsInteger() int {
+	return int(v)
+}
+
+func (v Offset) IsDefined() bool {
+	return true
+}
+
 type Word string
 
 func (v Word) AsSource() string {
@@ -2004,6 +4150,36 @@ func (v Word) IsBefore(
 	return sli.Compare([]byte(v), []byte(value)) < 0
 }
 
+type Letter string
+
+func (v Letter) AsSource() string {
+	return string(v)
+}
+
+func (v Letter) IsBefore(
+	value Letter,
+) bool {
+	return v < value
+}
+
+func (v Letter) GetNext() Letter {
+	return Letter(rune(v[0]) + 1)
+}
+
+type Measure float64
+
+func (v Measure) AsSource() string {
+	return fmt.Sprintf("%v", float64(v))
+}
+
+func (v Measure) AsFloat() float64 {
+	return float64(v)
+}
+
+func (v Measure) IsDefined() bool {
+	return true
+}
+
 type Number float64
 
 func (v Number) AsSource() string {
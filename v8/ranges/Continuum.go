@@ -16,6 +16,7 @@ import (
 	fmt "fmt"
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	col "github.com/craterdog/go-collection-framework/v8/collections"
+	mat "math"
 	syn "sync"
 )
 
@@ -140,6 +141,43 @@ func (v *continuum_[V]) ContainsAll(
 	return true
 }
 
+// age.Serializable Methods
+
+func (v *continuum_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeValue(v.left_)
+	encoder.EncodeValue(v.minimum_)
+	encoder.EncodeValue(v.maximum_)
+	encoder.EncodeValue(v.right_)
+}
+
+func (v *continuum_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var left, right Bracket
+	var minimum, maximum V
+	if decoder.DecodeValue(&left) != nil ||
+		decoder.DecodeValue(&minimum) != nil ||
+		decoder.DecodeValue(&maximum) != nil ||
+		decoder.DecodeValue(&right) != nil {
+		return
+	}
+
+	// The decoded state is only kept if it is valid.
+	var decoded = *v
+	decoded.left_ = left
+	decoded.minimum_ = minimum
+	decoded.maximum_ = maximum
+	decoded.right_ = right
+	var err = decoded.checkContinuum()
+	if err != nil {
+		decoder.ReportError(err)
+		return
+	}
+	*v = decoded
+}
+
 // PROTECTED INTERFACE
 
 func (v *continuum_[V]) String() string {
@@ -170,16 +208,23 @@ func (v *continuum_[V]) String() string {
 
 // This method ensures that the endpoints are valid.
 func (v *continuum_[V]) validateContinuum() {
+	var err = v.checkContinuum()
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+// This method returns an error if the endpoints are not valid.
+func (v *continuum_[V]) checkContinuum() error {
 	// Validate the left bracket.
 	switch v.left_ {
 	case Inclusive:
 	case Exclusive:
 	default:
-		var message = fmt.Sprintf(
+		return fmt.Errorf(
 			"Received an invalid left bracket for a continuum: %v",
 			v.left_,
 		)
-		panic(message)
 	}
 
 	// Validate the right bracket.
@@ -187,33 +232,36 @@ func (v *continuum_[V]) validateContinuum() {
 	case Inclusive:
 	case Exclusive:
 	default:
-		var message = fmt.Sprintf(
+		return fmt.Errorf(
 			"Received an invalid right bracket for a continuum: %v",
 			v.right_,
 		)
-		panic(message)
 	}
 
-	// Validate the endpoints.
+	// Validate the endpoints.  A defined endpoint that is NaN would make every
+	// comparison with it false.
+	if v.minimum_.IsDefined() && mat.IsNaN(v.minimum_.AsFloat()) {
+		return fmt.Errorf(
+			"The minimum %v in a continuum cannot be NaN.",
+			v.minimum_,
+		)
+	}
+	if v.maximum_.IsDefined() && mat.IsNaN(v.maximum_.AsFloat()) {
+		return fmt.Errorf(
+			"The maximum %v in a continuum cannot be NaN.",
+			v.maximum_,
+		)
+	}
 	if v.minimum_.IsDefined() && v.maximum_.IsDefined() {
-		var collator = age.CollatorClass[V]().Collator()
-		if collator.RankValues(v.minimum_, v.maximum_) != age.LesserRank {
-			var message = fmt.Sprintf(
+		if v.minimum_.AsFloat() >= v.maximum_.AsFloat() {
+			return fmt.Errorf(
 				"The minimum %v in a continuum must be less than the maximum %v.",
 				v.minimum_,
 				v.maximum_,
 			)
-			panic(message)
-		}
-		var size = v.maximum_.AsFloat() - v.minimum_.AsFloat()
-		if size <= 0 {
-			var message = fmt.Sprintf(
-				"The size of a continuum must be greater than zero: %v.",
-				size,
-			)
-			panic(message)
 		}
 	}
+	return nil
 }
 
 // Instance Structure
@@ -255,6 +303,9 @@ func continuumClass[V Continuous]() *continuumClass_[V] {
 			// Initialize the class constants.
 		}
 		continuumMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&continuum_[V]{})
 	}
 	continuumMutex_.Unlock()
 
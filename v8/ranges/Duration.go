@@ -0,0 +1,175 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ranges
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DurationClass() DurationClassLike {
+	return durationClass()
+}
+
+// Constructor Methods
+
+func (c *durationClass_) Duration(
+	duration tim.Duration,
+) DurationLike {
+	return duration_(duration.Milliseconds())
+}
+
+func (c *durationClass_) DurationFromString(
+	source string,
+) DurationLike {
+	var matches = c.matcher_.FindStringSubmatch(source)
+	if matches == nil || sts.HasSuffix(source, "P") || sts.HasSuffix(source, "T") {
+		var message = fmt.Sprintf(
+			"An invalid duration was passed to the constructor: %v",
+			source,
+		)
+		panic(message)
+	}
+	var duration tim.Duration
+	var units = []tim.Duration{24 * tim.Hour, tim.Hour, tim.Minute, tim.Second}
+	for index, unit := range units {
+		var match = matches[index+2]
+		if len(match) > 0 {
+			var number, _ = stc.ParseFloat(match, 64)
+			duration += tim.Duration(number * float64(unit))
+		}
+	}
+	if matches[1] == "-" {
+		duration = -duration
+	}
+	return c.Duration(duration)
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *durationClass_) Between(
+	first MomentLike,
+	second MomentLike,
+) DurationLike {
+	return duration_(int64(second.AsFloat() - first.AsFloat()))
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v duration_) GetClass() DurationClassLike {
+	return durationClass()
+}
+
+func (v duration_) AsDuration() tim.Duration {
+	return tim.Duration(v) * tim.Millisecond
+}
+
+// Continuous Methods
+
+func (v duration_) AsFloat() float64 {
+	return float64(v)
+}
+
+func (v duration_) IsDefined() bool {
+	return true
+}
+
+// Ordered[DurationLike] Methods
+
+func (v duration_) AsSource() string {
+	var source = "P"
+	var milliseconds = int64(v)
+	if milliseconds < 0 {
+		source = "-P"
+		milliseconds = -milliseconds
+	}
+	var days = milliseconds / int64(24*tim.Hour/tim.Millisecond)
+	milliseconds -= days * int64(24*tim.Hour/tim.Millisecond)
+	if days > 0 {
+		source += fmt.Sprintf("%vD", days)
+		if milliseconds == 0 {
+			return source
+		}
+	}
+	source += "T"
+	var hours = milliseconds / int64(tim.Hour/tim.Millisecond)
+	milliseconds -= hours * int64(tim.Hour/tim.Millisecond)
+	var minutes = milliseconds / int64(tim.Minute/tim.Millisecond)
+	milliseconds -= minutes * int64(tim.Minute/tim.Millisecond)
+	if hours > 0 {
+		source += fmt.Sprintf("%vH", hours)
+	}
+	if minutes > 0 {
+		source += fmt.Sprintf("%vM", minutes)
+	}
+	if milliseconds > 0 || (hours == 0 && minutes == 0) {
+		var seconds = stc.FormatFloat(float64(milliseconds)/1000.0, 'f', -1, 64)
+		source += seconds + "S"
+	}
+	return source
+}
+
+func (v duration_) IsBefore(
+	value DurationLike,
+) bool {
+	return v.AsFloat() < value.AsFloat()
+}
+
+// PROTECTED INTERFACE
+
+func (v duration_) String() string {
+	return v.AsSource()
+}
+
+// Private Methods
+
+// Instance Structure
+
+type duration_ int64
+
+// Class Structure
+
+type durationClass_ struct {
+	// Declare the class constants.
+	matcher_ *reg.Regexp
+}
+
+// Class Reference
+
+func durationClass() *durationClass_ {
+	return durationClassReference_
+}
+
+var durationClassReference_ = &durationClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		`^(-?)P(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`,
+	),
+}
+
+// The duration type is registered so that durations can be decoded.
+func init() {
+	age.EncoderClass().RegisterType(duration_(0))
+}
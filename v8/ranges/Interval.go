@@ -17,8 +17,7 @@ import (
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	col "github.com/craterdog/go-collection-framework/v8/collections"
 	uti "github.com/craterdog/go-missing-utilities/v8"
-	ref "reflect"
-	sts "strings"
+	mat "math"
 	syn "sync"
 )
 
@@ -49,6 +48,28 @@ func (c *intervalClass_[V]) Interval(
 	return instance
 }
 
+func (c *intervalClass_[V]) IntervalWithFactory(
+	left Bracket,
+	minimum V,
+	maximum V,
+	right Bracket,
+	factory FactoryFunction[V],
+) IntervalLike[V] {
+	if uti.IsUndefined(factory) {
+		panic("The \"factory\" attribute is required by this class.")
+	}
+	var instance = &interval_[V]{
+		// Initialize the instance attributes.
+		left_:    left,
+		minimum_: minimum,
+		maximum_: maximum,
+		right_:   right,
+		factory_: factory,
+	}
+	instance.validateInterval()
+	return instance
+}
+
 // Constant Methods
 
 // Function Methods
@@ -61,6 +82,22 @@ func (v *interval_[V]) GetClass() IntervalClassLike[V] {
 	return intervalClass[V]()
 }
 
+// Attribute Methods
+
+func (v *interval_[V]) GetFactory() FactoryFunction[V] {
+	return v.factory_
+}
+
+func (v *interval_[V]) GetLimit() uint {
+	return v.limit_
+}
+
+func (v *interval_[V]) SetLimit(
+	limit uint,
+) {
+	v.limit_ = limit
+}
+
 // Accessible[V] Methods
 
 func (v *interval_[V]) GetValue(
@@ -75,29 +112,35 @@ func (v *interval_[V]) GetValues(
 	first int,
 	last int,
 ) col.Sequential[V] {
-	var firstValue = v.GetValue(first)
-	var lastValue = v.GetValue(last)
-	return v.GetClass().Interval(
-		Inclusive,
-		firstValue,
-		lastValue,
-		Inclusive,
-	)
+	// The sub-interval is created without materializing any of its elements.
+	var size = v.effectiveSize()
+	var minimum = v.effectiveMinimum()
+	var firstOffset = minimum + uti.RelativeToCardinal(first, size)
+	var lastOffset = minimum + uti.RelativeToCardinal(last, size)
+	var values = &interval_[V]{
+		// Initialize the instance attributes.
+		left_:    Inclusive,
+		minimum_: v.valueOf(firstOffset),
+		maximum_: v.valueOf(lastOffset),
+		right_:   Inclusive,
+		factory_: v.factory_,
+		limit_:   v.limit_,
+	}
+	values.validateInterval()
+	return values
 }
 
 func (v *interval_[V]) GetIndex(
 	value V,
 ) int {
-	var index = 1
-	var iterator = v.GetIterator()
-	for iterator.HasNext() {
-		var candidate = iterator.GetNext()
-		if candidate.AsInteger() == value.AsInteger() {
-			return index
-		}
-		index++
+	// The index of a value is determined by its offset from the minimum.
+	var integer = value.AsInteger()
+	var minimum = v.effectiveMinimum()
+	if integer < minimum || integer > v.effectiveMaximum() {
+		// The value is not in this interval.
+		return 0
 	}
-	return 0
+	return integer - minimum + 1
 }
 
 // Bounded[V] Methods
@@ -182,6 +225,49 @@ func (v *interval_[V]) ContainsAll(
 	return true
 }
 
+// age.Serializable Methods
+
+func (v *interval_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeValue(v.left_)
+	encoder.EncodeValue(v.minimum_)
+	encoder.EncodeValue(v.maximum_)
+	encoder.EncodeValue(v.right_)
+	encoder.EncodeValue(v.limit_)
+}
+
+// A factory function cannot be encoded, so a decoded interval retains the
+// factory function (if any) of the interval that it was decoded into.
+func (v *interval_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var left, right Bracket
+	var minimum, maximum V
+	var limit uint
+	if decoder.DecodeValue(&left) != nil ||
+		decoder.DecodeValue(&minimum) != nil ||
+		decoder.DecodeValue(&maximum) != nil ||
+		decoder.DecodeValue(&right) != nil ||
+		decoder.DecodeValue(&limit) != nil {
+		return
+	}
+
+	// The decoded state is only kept if it is valid.
+	var decoded = *v
+	decoded.left_ = left
+	decoded.minimum_ = minimum
+	decoded.maximum_ = maximum
+	decoded.right_ = right
+	decoded.limit_ = limit
+	var err = decoded.checkInterval()
+	if err != nil {
+		decoder.ReportError(err)
+		return
+	}
+	*v = decoded
+}
+
 // col.Sequential[V] Methods
 
 func (v *interval_[V]) IsEmpty() bool {
@@ -193,16 +279,19 @@ func (v *interval_[V]) GetSize() uint {
 }
 
 func (v *interval_[V]) AsArray() []V {
-	var size = int(v.effectiveSize())
-	if size > 256 {
-		// Limit the size to something reasonable.
-		size = 256
+	var size = v.effectiveSize()
+	if v.limit_ > 0 && size > v.limit_ {
+		var message = fmt.Sprintf(
+			"The size of the interval %v exceeds its materialization limit: %v",
+			size,
+			v.limit_,
+		)
+		panic(message)
 	}
+	var minimum = v.effectiveMinimum()
 	var array = make([]V, size)
-	for index := 0; index < size; index++ {
-		var offset = v.effectiveMinimum() + index
-		var value = v.valueOf(offset)
-		array[index] = value
+	for index := range array {
+		array[index] = v.valueOf(minimum + index)
 	}
 	return array
 }
@@ -254,6 +343,8 @@ func (v *interval_[V]) effectiveMinimum() int {
 	return minimum
 }
 
+// This method returns the number of elements in the interval.  The endpoints
+// have been validated so the size cannot overflow.
 func (v *interval_[V]) effectiveSize() uint {
 	var size = uint(v.effectiveMaximum() - v.effectiveMinimum() + 1)
 	return size
@@ -261,16 +352,23 @@ func (v *interval_[V]) effectiveSize() uint {
 
 // This method ensures that the endpoints are valid.
 func (v *interval_[V]) validateInterval() {
+	var err = v.checkInterval()
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+// This method returns an error if the endpoints are not valid.
+func (v *interval_[V]) checkInterval() error {
 	// Validate the left bracket.
 	switch v.left_ {
 	case Inclusive:
 	case Exclusive:
 	default:
-		var message = fmt.Sprintf(
+		return fmt.Errorf(
 			"Received an invalid left bracket for an interval: %v",
 			v.left_,
 		)
-		panic(message)
 	}
 
 	// Validate the right bracket.
@@ -278,52 +376,64 @@ func (v *interval_[V]) validateInterval() {
 	case Inclusive:
 	case Exclusive:
 	default:
-		var message = fmt.Sprintf(
+		return fmt.Errorf(
 			"Received an invalid right bracket for an interval: %v",
 			v.right_,
 		)
-		panic(message)
+	}
+
+	// Validate that the elements can be constructed.
+	var _, isSuccessive = any(v.minimum_).(Successive[V])
+	if v.factory_ == nil && !isSuccessive {
+		return fmt.Errorf(
+			"The elements of an interval of type %T cannot be constructed without a factory function.",
+			v.minimum_,
+		)
 	}
 
 	// Validate the endpoints.
 	if v.minimum_.IsDefined() && v.maximum_.IsDefined() {
-		var collator = age.CollatorClass[V]().Collator()
-		if collator.RankValues(v.minimum_, v.maximum_) != age.LesserRank {
-			var message = fmt.Sprintf(
-				"The minimum %v in an interval must be less than the maximum %v.",
+		if v.minimum_.AsInteger() > v.maximum_.AsInteger() {
+			return fmt.Errorf(
+				"The minimum %v in an interval cannot be greater than the maximum %v.",
 				v.minimum_,
 				v.maximum_,
 			)
-			panic(message)
 		}
-		var size = v.effectiveSize()
-		if size <= 0 {
-			var message = fmt.Sprintf(
+		// The span is calculated using unsigned integers so that it cannot
+		// overflow, even for the interval [MinInt..MaxInt].
+		var span = uint(v.maximum_.AsInteger()) - uint(v.minimum_.AsInteger())
+		var brackets = uint(v.left_) + uint(v.right_)
+		if span < brackets {
+			return fmt.Errorf(
 				"The effective size of an interval must be greater than zero: %v.",
-				size,
+				int(span)+1-int(brackets),
+			)
+		}
+		if span-brackets >= mat.MaxInt {
+			// Each element must have a positive index.
+			return fmt.Errorf(
+				"The effective size of the interval %v exceeds the maximum size: %v",
+				v,
+				mat.MaxInt,
 			)
-			panic(message)
 		}
 	}
+	return nil
 }
 
+// This method returns the element of the interval having the specified integer
+// form.  Without a factory function the element is found by stepping through
+// the successors of the minimum, which the constructor has verified exist.
 func (v *interval_[V]) valueOf(offset int) V {
-	// We cannot get access to the constructor we need without reflection.
-	var offsetRef = ref.ValueOf(offset)
-	var resultRef ref.Value
-	var valueRef = ref.ValueOf(v.minimum_)
-	var method = valueRef.MethodByName("GetClass")
-	var classRef = method.Call([]ref.Value{})[0]
-	var typeRef = classRef.Type()
-	var count = typeRef.NumMethod()
-	for index := 0; index < count; index++ {
-		var name = typeRef.Method(index).Name
-		if sts.HasSuffix(name, "FromInteger") {
-			resultRef = classRef.MethodByName(name).Call([]ref.Value{offsetRef})[0]
-			break
-		}
+	if v.factory_ != nil {
+		return v.factory_(offset)
+	}
+	var value = v.minimum_
+	for range offset - v.minimum_.AsInteger() {
+		value = any(value).(Successive[V]).GetNext()
 	}
-	return resultRef.Interface().(V)
+	return value
 }
 
 // Instance Structure
@@ -334,6 +444,8 @@ type interval_[V Discrete] struct {
 	minimum_ V
 	maximum_ V
 	right_   Bracket
+	factory_ FactoryFunction[V]
+	limit_   uint
 }
 
 // Class Structure
@@ -365,6 +477,9 @@ func intervalClass[V Discrete]() *intervalClass_[V] {
 			// Initialize the class constants.
 		}
 		intervalMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&interval_[V]{})
 	}
 	intervalMutex_.Unlock()
 
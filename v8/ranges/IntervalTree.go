@@ -0,0 +1,559 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ranges
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	col "github.com/craterdog/go-collection-framework/v8/collections"
+	uti "github.com/craterdog/go-missing-utilities/v8"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func IntervalTreeClass[R Ranged[E], E any, V any]() IntervalTreeClassLike[R, E, V] {
+	return intervalTreeClass[R, E, V]()
+}
+
+// Constructor Methods
+
+func (c *intervalTreeClass_[R, E, V]) IntervalTree() IntervalTreeLike[R, E, V] {
+	var ranker = age.CollatorClass[E]().Collator().RankValues
+	var instance = c.IntervalTreeWithRanker(ranker)
+	return instance
+}
+
+func (c *intervalTreeClass_[R, E, V]) IntervalTreeWithRanker(
+	ranker age.RankingFunction[E],
+) IntervalTreeLike[R, E, V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	var instance = &intervalTree_[R, E, V]{
+		// Initialize the instance attributes.
+		ranker_: ranker,
+		nodes_:  map[R]*treeNode_[R, E, V]{},
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *intervalTree_[R, E, V]) GetClass() IntervalTreeClassLike[R, E, V] {
+	return intervalTreeClass[R, E, V]()
+}
+
+func (v *intervalTree_[R, E, V]) GetValue(
+	range_ R,
+) V {
+	var value V // Set the return value to its zero value.
+	var node, exists = v.nodes_[range_]
+	if exists {
+		// Extract the value.
+		value = node.association_.GetValue()
+	}
+	return value
+}
+
+func (v *intervalTree_[R, E, V]) SetValue(
+	range_ R,
+	value V,
+) {
+	var node, exists = v.nodes_[range_]
+	if exists {
+		// Set the value of an existing association.
+		node.association_.SetValue(value)
+		return
+	}
+
+	// Add a new association.
+	var associationClass = col.AssociationClass[R, V]()
+	v.sequence_++
+	node = &treeNode_[R, E, V]{
+		association_: associationClass.Association(range_, value),
+		sequence_:    v.sequence_,
+		height_:      1,
+		upper_:       range_,
+	}
+	v.root_ = v.insertNode(v.root_, node)
+	v.nodes_[range_] = node
+}
+
+func (v *intervalTree_[R, E, V]) RemoveValue(
+	range_ R,
+) V {
+	var old V // Set the return value to its zero value.
+	var node, exists = v.nodes_[range_]
+	if exists {
+		v.root_ = v.removeNode(v.root_, node)
+		old = node.association_.GetValue()
+		delete(v.nodes_, range_)
+	}
+	return old
+}
+
+func (v *intervalTree_[R, E, V]) RemoveAll() {
+	v.root_ = nil
+	v.nodes_ = map[R]*treeNode_[R, E, V]{}
+}
+
+func (v *intervalTree_[R, E, V]) GetContaining(
+	element E,
+) col.Sequential[col.AssociationLike[R, V]] {
+	var array []col.AssociationLike[R, V]
+	v.collectAssociations(
+		v.root_,
+		func(range_ R) bool {
+			return v.isBelow(range_, element)
+		},
+		func(range_ R) bool {
+			return v.isAbove(range_, element)
+		},
+		&array,
+	)
+	var listClass = col.ListClass[col.AssociationLike[R, V]]()
+	var associations = listClass.ListFromArray(array)
+	return associations
+}
+
+func (v *intervalTree_[R, E, V]) GetOverlapping(
+	range_ R,
+) col.Sequential[col.AssociationLike[R, V]] {
+	var array []col.AssociationLike[R, V]
+	v.collectAssociations(
+		v.root_,
+		func(candidate R) bool {
+			return v.endsBefore(candidate, range_)
+		},
+		func(candidate R) bool {
+			return v.endsBefore(range_, candidate)
+		},
+		&array,
+	)
+	var listClass = col.ListClass[col.AssociationLike[R, V]]()
+	var associations = listClass.ListFromArray(array)
+	return associations
+}
+
+// Attribute Methods
+
+func (v *intervalTree_[R, E, V]) GetRanker() age.RankingFunction[E] {
+	return v.ranker_
+}
+
+// col.Sequential[col.AssociationLike[R, V]] Methods
+
+func (v *intervalTree_[R, E, V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *intervalTree_[R, E, V]) GetSize() uint {
+	return uint(len(v.nodes_))
+}
+
+func (v *intervalTree_[R, E, V]) AsArray() []col.AssociationLike[R, V] {
+	var array = make([]col.AssociationLike[R, V], 0, len(v.nodes_))
+	v.collectAssociations(
+		v.root_,
+		func(range_ R) bool { return false },
+		func(range_ R) bool { return false },
+		&array,
+	)
+	return array
+}
+
+func (v *intervalTree_[R, E, V]) GetIterator() uti.IteratorLike[col.AssociationLike[R, V]] {
+	var array = v.AsArray()
+	var iterator = uti.Iterator(array)
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *intervalTree_[R, E, V]) String() string {
+	return uti.Format(v)
+}
+
+// Private Methods
+
+// This method appends to the specified array, in order, the associations in
+// the specified subtree whose ranges lie neither entirely below nor entirely
+// above the query.  The subtrees that cannot contain any matching ranges are
+// pruned using the greatest upper endpoint maintained by each node.
+func (v *intervalTree_[R, E, V]) collectAssociations(
+	node *treeNode_[R, E, V],
+	isBelow func(range_ R) bool,
+	isAbove func(range_ R) bool,
+	array *[]col.AssociationLike[R, V],
+) {
+	if node == nil || isBelow(node.upper_) {
+		// No range in this subtree reaches the query.
+		return
+	}
+	v.collectAssociations(node.left_, isBelow, isAbove, array)
+	var range_ = node.association_.GetKey()
+	if isAbove(range_) {
+		// This range and all ranges that follow it lie beyond the query.
+		return
+	}
+	if !isBelow(range_) {
+		*array = append(*array, node.association_)
+	}
+	v.collectAssociations(node.right_, isBelow, isAbove, array)
+}
+
+// This method determines whether or not the first range ends before the second
+// range begins.
+func (v *intervalTree_[R, E, V]) endsBefore(
+	first R,
+	second R,
+) bool {
+	var maximum = first.GetMaximum()
+	var minimum = second.GetMinimum()
+	if !v.isDefined(maximum) || !v.isDefined(minimum) {
+		// At least one of the endpoints is unbounded.
+		return false
+	}
+	switch v.ranker_(maximum, minimum) {
+	case age.LesserRank:
+		return true
+	case age.EqualRank:
+		return first.GetRight() == Exclusive || second.GetLeft() == Exclusive
+	default:
+		return false
+	}
+}
+
+// This method determines whether or not the specified range begins after the
+// specified element.
+func (v *intervalTree_[R, E, V]) isAbove(
+	range_ R,
+	element E,
+) bool {
+	var minimum = range_.GetMinimum()
+	if !v.isDefined(minimum) {
+		// The range is unbounded below.
+		return false
+	}
+	switch v.ranker_(element, minimum) {
+	case age.LesserRank:
+		return true
+	case age.EqualRank:
+		return range_.GetLeft() == Exclusive
+	default:
+		return false
+	}
+}
+
+// This method determines whether or not the specified range ends before the
+// specified element.
+func (v *intervalTree_[R, E, V]) isBelow(
+	range_ R,
+	element E,
+) bool {
+	var maximum = range_.GetMaximum()
+	if !v.isDefined(maximum) {
+		// The range is unbounded above.
+		return false
+	}
+	switch v.ranker_(maximum, element) {
+	case age.LesserRank:
+		return true
+	case age.EqualRank:
+		return range_.GetRight() == Exclusive
+	default:
+		return false
+	}
+}
+
+// This method determines whether or not the specified endpoint is bounded.
+// Only endpoint types that support an IsDefined() method can be unbounded.
+func (v *intervalTree_[R, E, V]) isDefined(
+	endpoint E,
+) bool {
+	if defined, ok := any(endpoint).(interface{ IsDefined() bool }); ok {
+		return defined.IsDefined()
+	}
+	return true
+}
+
+// This method ranks the minimum endpoints of two ranges.  An unbounded minimum
+// is ranked before all others and an inclusive minimum is ranked before an
+// exclusive minimum with the same value.
+func (v *intervalTree_[R, E, V]) rankMinimums(
+	first R,
+	second R,
+) age.Rank {
+	var firstMinimum = first.GetMinimum()
+	var secondMinimum = second.GetMinimum()
+	var firstDefined = v.isDefined(firstMinimum)
+	var secondDefined = v.isDefined(secondMinimum)
+	switch {
+	case !firstDefined && !secondDefined:
+		return age.EqualRank
+	case !firstDefined:
+		return age.LesserRank
+	case !secondDefined:
+		return age.GreaterRank
+	}
+	var rank = v.ranker_(firstMinimum, secondMinimum)
+	if rank == age.EqualRank {
+		rank = v.rankBrackets(first.GetLeft(), second.GetLeft())
+	}
+	return rank
+}
+
+// This method ranks the maximum endpoints of two ranges.  An unbounded maximum
+// is ranked after all others and an exclusive maximum is ranked before an
+// inclusive maximum with the same value.
+func (v *intervalTree_[R, E, V]) rankMaximums(
+	first R,
+	second R,
+) age.Rank {
+	var firstMaximum = first.GetMaximum()
+	var secondMaximum = second.GetMaximum()
+	var firstDefined = v.isDefined(firstMaximum)
+	var secondDefined = v.isDefined(secondMaximum)
+	switch {
+	case !firstDefined && !secondDefined:
+		return age.EqualRank
+	case !firstDefined:
+		return age.GreaterRank
+	case !secondDefined:
+		return age.LesserRank
+	}
+	var rank = v.ranker_(firstMaximum, secondMaximum)
+	if rank == age.EqualRank {
+		rank = v.rankBrackets(second.GetRight(), first.GetRight())
+	}
+	return rank
+}
+
+func (v *intervalTree_[R, E, V]) rankBrackets(
+	first Bracket,
+	second Bracket,
+) age.Rank {
+	switch {
+	case first < second:
+		return age.LesserRank
+	case first > second:
+		return age.GreaterRank
+	default:
+		return age.EqualRank
+	}
+}
+
+// This method ranks two nodes by their minimum endpoints, then their maximum
+// endpoints and finally the order in which they were added to the tree.
+func (v *intervalTree_[R, E, V]) rankNodes(
+	first *treeNode_[R, E, V],
+	second *treeNode_[R, E, V],
+) age.Rank {
+	var firstRange = first.association_.GetKey()
+	var secondRange = second.association_.GetKey()
+	var rank = v.rankMinimums(firstRange, secondRange)
+	if rank == age.EqualRank {
+		rank = v.rankMaximums(firstRange, secondRange)
+	}
+	if rank == age.EqualRank {
+		switch {
+		case first.sequence_ < second.sequence_:
+			rank = age.LesserRank
+		case first.sequence_ > second.sequence_:
+			rank = age.GreaterRank
+		}
+	}
+	return rank
+}
+
+// NOTE:
+// The following methods maintain the tree as an AVL tree so that its height is
+// always O[log(n)].  Each node also maintains the range in its subtree having
+// the greatest maximum endpoint which allows the queries to prune the subtrees
+// that cannot contain any matching ranges.  The algorithm is documented here:
+//   - https://en.wikipedia.org/wiki/Interval_tree#Augmented_tree
+
+func (v *intervalTree_[R, E, V]) insertNode(
+	subtree *treeNode_[R, E, V],
+	node *treeNode_[R, E, V],
+) *treeNode_[R, E, V] {
+	if subtree == nil {
+		return node
+	}
+	if v.rankNodes(node, subtree) == age.LesserRank {
+		subtree.left_ = v.insertNode(subtree.left_, node)
+	} else {
+		subtree.right_ = v.insertNode(subtree.right_, node)
+	}
+	return v.balanceNode(subtree)
+}
+
+func (v *intervalTree_[R, E, V]) removeNode(
+	subtree *treeNode_[R, E, V],
+	node *treeNode_[R, E, V],
+) *treeNode_[R, E, V] {
+	if subtree == nil {
+		return nil
+	}
+	switch v.rankNodes(node, subtree) {
+	case age.LesserRank:
+		subtree.left_ = v.removeNode(subtree.left_, node)
+	case age.GreaterRank:
+		subtree.right_ = v.removeNode(subtree.right_, node)
+	default:
+		// Found the node, so replace it with its successor (if any).
+		if subtree.left_ == nil {
+			return subtree.right_
+		}
+		if subtree.right_ == nil {
+			return subtree.left_
+		}
+		var successor = subtree.right_
+		for successor.left_ != nil {
+			successor = successor.left_
+		}
+		successor.right_ = v.removeNode(subtree.right_, successor)
+		successor.left_ = subtree.left_
+		subtree = successor
+	}
+	return v.balanceNode(subtree)
+}
+
+func (v *intervalTree_[R, E, V]) balanceNode(
+	node *treeNode_[R, E, V],
+) *treeNode_[R, E, V] {
+	v.updateNode(node)
+	var balance = v.heightOf(node.left_) - v.heightOf(node.right_)
+	switch {
+	case balance > 1:
+		// The left subtree is too tall.
+		if v.heightOf(node.left_.left_) < v.heightOf(node.left_.right_) {
+			node.left_ = v.rotateLeft(node.left_)
+		}
+		node = v.rotateRight(node)
+	case balance < -1:
+		// The right subtree is too tall.
+		if v.heightOf(node.right_.right_) < v.heightOf(node.right_.left_) {
+			node.right_ = v.rotateRight(node.right_)
+		}
+		node = v.rotateLeft(node)
+	}
+	return node
+}
+
+func (v *intervalTree_[R, E, V]) rotateLeft(
+	node *treeNode_[R, E, V],
+) *treeNode_[R, E, V] {
+	var pivot = node.right_
+	node.right_ = pivot.left_
+	pivot.left_ = node
+	v.updateNode(node)
+	v.updateNode(pivot)
+	return pivot
+}
+
+func (v *intervalTree_[R, E, V]) rotateRight(
+	node *treeNode_[R, E, V],
+) *treeNode_[R, E, V] {
+	var pivot = node.left_
+	node.left_ = pivot.right_
+	pivot.right_ = node
+	v.updateNode(node)
+	v.updateNode(pivot)
+	return pivot
+}
+
+func (v *intervalTree_[R, E, V]) heightOf(
+	node *treeNode_[R, E, V],
+) int {
+	if node == nil {
+		return 0
+	}
+	return node.height_
+}
+
+func (v *intervalTree_[R, E, V]) updateNode(
+	node *treeNode_[R, E, V],
+) {
+	node.height_ = max(v.heightOf(node.left_), v.heightOf(node.right_)) + 1
+	node.upper_ = node.association_.GetKey()
+	for _, child := range []*treeNode_[R, E, V]{node.left_, node.right_} {
+		if child != nil && v.rankMaximums(child.upper_, node.upper_) == age.GreaterRank {
+			node.upper_ = child.upper_
+		}
+	}
+}
+
+// Instance Structure
+
+type intervalTree_[R Ranged[E], E any, V any] struct {
+	// Declare the instance attributes.
+	ranker_   age.RankingFunction[E]
+	root_     *treeNode_[R, E, V]
+	nodes_    map[R]*treeNode_[R, E, V]
+	sequence_ uint64
+}
+
+type treeNode_[R Ranged[E], E any, V any] struct {
+	association_ col.AssociationLike[R, V]
+	sequence_    uint64
+	height_      int
+	upper_       R
+	left_        *treeNode_[R, E, V]
+	right_       *treeNode_[R, E, V]
+}
+
+// Class Structure
+
+type intervalTreeClass_[R Ranged[E], E any, V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var intervalTreeMap_ = map[string]any{}
+var intervalTreeMutex_ syn.Mutex
+
+func intervalTreeClass[R Ranged[E], E any, V any]() *intervalTreeClass_[R, E, V] {
+	// Generate the name of the bound class type.
+	var class *intervalTreeClass_[R, E, V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	intervalTreeMutex_.Lock()
+	var value = intervalTreeMap_[name]
+	switch actual := value.(type) {
+	case *intervalTreeClass_[R, E, V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &intervalTreeClass_[R, E, V]{
+			// Initialize the class constants.
+		}
+		intervalTreeMap_[name] = class
+	}
+	intervalTreeMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -0,0 +1,269 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ranges
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-collection-framework/v8/agents"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func MomentClass() MomentClassLike {
+	return momentClass()
+}
+
+// Constructor Methods
+
+func (c *momentClass_) Moment(
+	time tim.Time,
+) MomentLike {
+	return moment_(time.UnixMilli())
+}
+
+func (c *momentClass_) MomentFromString(
+	source string,
+) MomentLike {
+	var time, err = tim.Parse(tim.RFC3339Nano, source)
+	if err != nil {
+		time, err = tim.Parse(c.dayFormat_, source)
+	}
+	if err != nil {
+		var message = fmt.Sprintf(
+			"An invalid moment was passed to the constructor: %v",
+			source,
+		)
+		panic(message)
+	}
+	return c.Moment(time)
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *momentClass_) Days(
+	first MomentLike,
+	last MomentLike,
+) IntervalLike[DayLike] {
+	var factory = func(integer int) DayLike {
+		return day_(integer)
+	}
+	return IntervalClass[DayLike]().IntervalWithFactory(
+		Inclusive,
+		first.AsDay(),
+		last.AsDay(),
+		Inclusive,
+		factory,
+	)
+}
+
+func (c *momentClass_) Hours(
+	first MomentLike,
+	last MomentLike,
+) IntervalLike[HourLike] {
+	var factory = func(integer int) HourLike {
+		return hour_(integer)
+	}
+	return IntervalClass[HourLike]().IntervalWithFactory(
+		Inclusive,
+		first.AsHour(),
+		last.AsHour(),
+		Inclusive,
+		factory,
+	)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v moment_) GetClass() MomentClassLike {
+	return momentClass()
+}
+
+func (v moment_) AsTime() tim.Time {
+	return tim.UnixMilli(int64(v)).UTC()
+}
+
+func (v moment_) AsDay() DayLike {
+	var class = momentClass()
+	return day_(class.unitsOf(v, class.millisecondsPerDay_))
+}
+
+func (v moment_) AsHour() HourLike {
+	var class = momentClass()
+	return hour_(class.unitsOf(v, class.millisecondsPerHour_))
+}
+
+// Continuous Methods
+
+func (v moment_) AsFloat() float64 {
+	return float64(v)
+}
+
+func (v moment_) IsDefined() bool {
+	return true
+}
+
+// Discrete Methods
+
+func (v moment_) AsInteger() int {
+	return int(v)
+}
+
+// Ordered[MomentLike] Methods
+
+func (v moment_) AsSource() string {
+	return v.AsTime().Format(momentClass().momentFormat_)
+}
+
+func (v moment_) IsBefore(
+	value MomentLike,
+) bool {
+	return v.AsFloat() < value.AsFloat()
+}
+
+// PROTECTED INTERFACE
+
+func (v moment_) String() string {
+	return v.AsSource()
+}
+
+// Private Methods
+
+// This method returns the number of whole units since the Unix epoch that
+// precede the specified moment.
+func (c *momentClass_) unitsOf(
+	moment MomentLike,
+	milliseconds int64,
+) int64 {
+	var units = int64(moment.AsFloat()) / milliseconds
+	if int64(moment.AsFloat())%milliseconds < 0 {
+		// Round toward the beginning of time rather than toward the epoch.
+		units--
+	}
+	return units
+}
+
+// Instance Structure
+
+type moment_ int64
+
+// Class Structure
+
+type momentClass_ struct {
+	// Declare the class constants.
+	momentFormat_        string
+	hourFormat_          string
+	dayFormat_           string
+	millisecondsPerHour_ int64
+	millisecondsPerDay_  int64
+}
+
+// Class Reference
+
+func momentClass() *momentClass_ {
+	return momentClassReference_
+}
+
+var momentClassReference_ = &momentClass_{
+	// Initialize the class constants.
+	momentFormat_:        "2006-01-02T15:04:05.000Z07:00",
+	hourFormat_:          "2006-01-02T15Z07:00",
+	dayFormat_:           "2006-01-02",
+	millisecondsPerHour_: int64(tim.Hour / tim.Millisecond),
+	millisecondsPerDay_:  int64(24 * tim.Hour / tim.Millisecond),
+}
+
+// The moment types are registered so that moments can be decoded.
+func init() {
+	var encoderClass = age.EncoderClass()
+	encoderClass.RegisterType(moment_(0))
+	encoderClass.RegisterType(hour_(0))
+	encoderClass.RegisterType(day_(0))
+}
+
+/*
+NOTE:
+The following are private implementations of the hours and days that contain a
+moment.  Their integer forms are the number of whole hours or days since the
+Unix epoch, which allows them to be the elements of an interval.
+*/
+
+type hour_ int64
+
+func (v hour_) AsMoment() MomentLike {
+	return moment_(int64(v) * momentClass().millisecondsPerHour_)
+}
+
+func (v hour_) AsTime() tim.Time {
+	return v.AsMoment().AsTime()
+}
+
+func (v hour_) IsDefined() bool {
+	return true
+}
+
+func (v hour_) AsInteger() int {
+	return int(v)
+}
+
+func (v hour_) AsSource() string {
+	return v.AsTime().Format(momentClass().hourFormat_)
+}
+
+func (v hour_) IsBefore(
+	value HourLike,
+) bool {
+	return v.AsInteger() < value.AsInteger()
+}
+
+func (v hour_) String() string {
+	return v.AsSource()
+}
+
+type day_ int64
+
+func (v day_) AsMoment() MomentLike {
+	return moment_(int64(v) * momentClass().millisecondsPerDay_)
+}
+
+func (v day_) AsTime() tim.Time {
+	return v.AsMoment().AsTime()
+}
+
+func (v day_) IsDefined() bool {
+	return true
+}
+
+func (v day_) AsInteger() int {
+	return int(v)
+}
+
+func (v day_) AsSource() string {
+	return v.AsTime().Format(momentClass().dayFormat_)
+}
+
+func (v day_) IsBefore(
+	value DayLike,
+) bool {
+	return v.AsInteger() < value.AsInteger()
+}
+
+func (v day_) String() string {
+	return v.AsSource()
+}
@@ -16,6 +16,7 @@ import (
 	fmt "fmt"
 	age "github.com/craterdog/go-collection-framework/v8/agents"
 	col "github.com/craterdog/go-collection-framework/v8/collections"
+	uti "github.com/craterdog/go-missing-utilities/v8"
 	syn "sync"
 )
 
@@ -58,6 +59,42 @@ func (v *spectrum_[V]) GetClass() SpectrumClassLike[V] {
 	return spectrumClass[V]()
 }
 
+func (v *spectrum_[V]) AsSequence(
+	limit uint,
+) (
+	sequence col.Sequential[V],
+	ok bool,
+) {
+	// Only spectrums of successive elements can be traversed.
+	var _, isSuccessive = any(v.minimum_).(Successive[V])
+	if !isSuccessive {
+		var message = fmt.Sprintf(
+			"The elements of the spectrum %v are not successive so it cannot be traversed.",
+			v,
+		)
+		panic(message)
+	}
+
+	// The sequence is based on the current endpoints of the spectrum.
+	var spectrum = *v
+	if limit > 0 {
+		// Count the elements without generating more than one beyond the limit.
+		var count uint
+		var candidate, found = spectrum.firstElement()
+		for found {
+			count++
+			if count > limit {
+				return
+			}
+			candidate, found = spectrum.nextElement(candidate)
+		}
+	}
+	sequence = &spectrumSequence_[V]{
+		spectrum_: &spectrum,
+	}
+	return sequence, true
+}
+
 // Bounded[V] Methods
 
 func (v *spectrum_[V]) GetLeft() Bracket {
@@ -140,6 +177,43 @@ func (v *spectrum_[V]) ContainsAll(
 	return true
 }
 
+// age.Serializable Methods
+
+func (v *spectrum_[V]) EncodeValues(
+	encoder age.EncoderLike,
+) {
+	encoder.EncodeValue(v.left_)
+	encoder.EncodeValue(v.minimum_)
+	encoder.EncodeValue(v.maximum_)
+	encoder.EncodeValue(v.right_)
+}
+
+func (v *spectrum_[V]) DecodeValues(
+	decoder age.DecoderLike,
+) {
+	var left, right Bracket
+	var minimum, maximum V
+	if decoder.DecodeValue(&left) != nil ||
+		decoder.DecodeValue(&minimum) != nil ||
+		decoder.DecodeValue(&maximum) != nil ||
+		decoder.DecodeValue(&right) != nil {
+		return
+	}
+
+	// The decoded state is only kept if it is valid.
+	var decoded = *v
+	decoded.left_ = left
+	decoded.minimum_ = minimum
+	decoded.maximum_ = maximum
+	decoded.right_ = right
+	var err = decoded.checkSpectrum()
+	if err != nil {
+		decoder.ReportError(err)
+		return
+	}
+	*v = decoded
+}
+
 // PROTECTED INTERFACE
 
 func (v *spectrum_[V]) String() string {
@@ -164,18 +238,71 @@ func (v *spectrum_[V]) String() string {
 
 // Private Methods
 
+// This method returns the first element in the spectrum, or false if the
+// spectrum contains no elements.
+func (v *spectrum_[V]) firstElement() (
+	element V,
+	ok bool,
+) {
+	element = v.minimum_
+	if v.left_ == Exclusive {
+		element = any(element).(Successive[V]).GetNext()
+	}
+	return element, v.includesCandidate(element)
+}
+
+// This method determines whether or not the specified candidate lies before the
+// end of the spectrum.
+func (v *spectrum_[V]) includesCandidate(
+	candidate V,
+) bool {
+	switch v.right_ {
+	case Inclusive:
+		return !v.maximum_.IsBefore(candidate)
+	default:
+		return candidate.IsBefore(v.maximum_)
+	}
+}
+
+// This method returns the element in the spectrum that follows the specified
+// element, or false if there are no more elements in the spectrum.
+func (v *spectrum_[V]) nextElement(
+	previous V,
+) (
+	element V,
+	ok bool,
+) {
+	element = any(previous).(Successive[V]).GetNext()
+	if !previous.IsBefore(element) {
+		var message = fmt.Sprintf(
+			"The successor %v of a spectrum element must be ordered after %v.",
+			element.AsSource(),
+			previous.AsSource(),
+		)
+		panic(message)
+	}
+	return element, v.includesCandidate(element)
+}
+
 // This method ensures that the endpoints are valid.
 func (v *spectrum_[V]) validateSpectrum() {
+	var err = v.checkSpectrum()
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+// This method returns an error if the endpoints are not valid.
+func (v *spectrum_[V]) checkSpectrum() error {
 	// Validate the left bracket.
 	switch v.left_ {
 	case Inclusive:
 	case Exclusive:
 	default:
-		var message = fmt.Sprintf(
+		return fmt.Errorf(
 			"Received an invalid left bracket for a spectrum: %v",
 			v.left_,
 		)
-		panic(message)
 	}
 
 	// Validate the right bracket.
@@ -183,23 +310,21 @@ func (v *spectrum_[V]) validateSpectrum() {
 	case Inclusive:
 	case Exclusive:
 	default:
-		var message = fmt.Sprintf(
+		return fmt.Errorf(
 			"Received an invalid right bracket for a spectrum: %v",
 			v.right_,
 		)
-		panic(message)
 	}
 
 	// Validate the endpoints.
-	var collator = age.CollatorClass[V]().Collator()
-	if collator.RankValues(v.minimum_, v.maximum_) != age.LesserRank {
-		var message = fmt.Sprintf(
+	if !v.minimum_.IsBefore(v.maximum_) {
+		return fmt.Errorf(
 			"The minimum %v in a spectrum must be less than the maximum %v.",
 			v.minimum_,
 			v.maximum_,
 		)
-		panic(message)
 	}
+	return nil
 }
 
 // Instance Structure
@@ -241,9 +366,143 @@ func spectrumClass[V Ordered[V]]() *spectrumClass_[V] {
 			// Initialize the class constants.
 		}
 		spectrumMap_[name] = class
+
+		// Register the instance type so that its instances can be decoded.
+		age.EncoderClass().RegisterType(&spectrum_[V]{})
 	}
 	spectrumMutex_.Unlock()
 
 	// Return a reference to the bound class type.
 	return class
 }
+
+/*
+NOTE:
+The following are private implementations of a sequence and its iterator that
+walk a spectrum of successive elements using their successor method.  The
+elements are only generated as the iterator reaches them, and are cached so that
+the iterator can move backwards over them as well.
+*/
+
+type spectrumSequence_[V Ordered[V]] struct {
+	spectrum_ *spectrum_[V]
+}
+
+func (v *spectrumSequence_[V]) IsEmpty() bool {
+	var _, found = v.spectrum_.firstElement()
+	return !found
+}
+
+func (v *spectrumSequence_[V]) GetSize() uint {
+	var size uint
+	var candidate, found = v.spectrum_.firstElement()
+	for found {
+		size++
+		candidate, found = v.spectrum_.nextElement(candidate)
+	}
+	return size
+}
+
+func (v *spectrumSequence_[V]) AsArray() []V {
+	var array []V
+	var candidate, found = v.spectrum_.firstElement()
+	for found {
+		array = append(array, candidate)
+		candidate, found = v.spectrum_.nextElement(candidate)
+	}
+	return array
+}
+
+func (v *spectrumSequence_[V]) GetIterator() uti.IteratorLike[V] {
+	var iterator = &spectrumIterator_[V]{
+		spectrum_: v.spectrum_,
+	}
+	return iterator
+}
+
+type spectrumIterator_[V Ordered[V]] struct {
+	slot_     uint
+	complete_ bool
+	values_   []V
+	spectrum_ *spectrum_[V]
+}
+
+func (v *spectrumIterator_[V]) IsEmpty() bool {
+	return !v.HasNext() && v.slot_ == 0
+}
+
+func (v *spectrumIterator_[V]) ToStart() {
+	v.slot_ = 0
+}
+
+func (v *spectrumIterator_[V]) ToEnd() {
+	v.slot_ = v.GetSize()
+}
+
+func (v *spectrumIterator_[V]) HasPrevious() bool {
+	return v.slot_ > 0
+}
+
+func (v *spectrumIterator_[V]) GetPrevious() V {
+	var result_ V
+	if v.slot_ > 0 {
+		result_ = v.values_[v.slot_-1]
+		v.slot_--
+	}
+	return result_
+}
+
+func (v *spectrumIterator_[V]) HasNext() bool {
+	return v.slot_ < uint(len(v.values_)) || v.generateValue()
+}
+
+func (v *spectrumIterator_[V]) GetNext() V {
+	var result_ V
+	if v.HasNext() {
+		result_ = v.values_[v.slot_]
+		v.slot_++
+	}
+	return result_
+}
+
+func (v *spectrumIterator_[V]) GetSize() uint {
+	for v.generateValue() {
+		// Generate all remaining elements.
+	}
+	return uint(len(v.values_))
+}
+
+func (v *spectrumIterator_[V]) GetSlot() uint {
+	return v.slot_
+}
+
+func (v *spectrumIterator_[V]) SetSlot(
+	slot uint,
+) {
+	for slot > uint(len(v.values_)) && v.generateValue() {
+		// Generate the elements up to the requested slot.
+	}
+	v.slot_ = min(slot, uint(len(v.values_)))
+}
+
+// This method appends the next element in the spectrum to the cached elements.
+// It returns false if there are no more elements in the spectrum.
+func (v *spectrumIterator_[V]) generateValue() bool {
+	if v.complete_ {
+		return false
+	}
+	var candidate V
+	var found bool
+	var count = len(v.values_)
+	if count > 0 {
+		candidate, found = v.spectrum_.nextElement(v.values_[count-1])
+	} else {
+		candidate, found = v.spectrum_.firstElement()
+	}
+	if !found {
+		v.complete_ = true
+		return false
+	}
+	v.values_ = append(v.values_, candidate)
+	return true
+}
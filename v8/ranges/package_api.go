@@ -17,6 +17,13 @@ values of a generic type:
   - Spectrum (an infinite discrete range)
   - Continuum (an infinite continuous range)
 
+It also declares some primitive classes whose values make convenient endpoints:
+  - Duration (a span of time)
+  - Moment (a point in time)
+
+It also declares a class that maintains a collection of ranges:
+  - IntervalTree (an ordered collection of ranges supporting overlap queries)
+
 For detailed documentation on this package refer to the wiki:
   - https://github.com/craterdog/go-collection-framework/wiki
 
@@ -32,7 +39,9 @@ on interfaces, not on each other.
 package ranges
 
 import (
+	age "github.com/craterdog/go-collection-framework/v8/agents"
 	col "github.com/craterdog/go-collection-framework/v8/collections"
+	tim "time"
 )
 
 // TYPE DECLARATIONS
@@ -50,6 +59,14 @@ const (
 
 // FUNCTIONAL DECLARATIONS
 
+/*
+FactoryFunction[V any] is a functional type that declares the signature for any
+function that can construct a discrete value from its integer form.
+*/
+type FactoryFunction[V any] func(
+	integer int,
+) V
+
 // CLASS DECLARATIONS
 
 /*
@@ -71,6 +88,37 @@ type ContinuumClassLike[V Continuous] interface {
 	) ContinuumLike[V]
 }
 
+/*
+DurationClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+duration-like class.
+
+A duration-like class captures a span of time with millisecond precision.  The
+source form of a duration is an ISO-8601 duration (e.g. "P1DT1H30M") and its
+float form is the number of milliseconds that it spans.  Since a duration is not
+tied to a calendar, each of its days is exactly 24 hours long.  The same form is
+both parsed and produced, so months and years are not supported.
+
+The following class functions are also supported:
+
+Between() returns the duration from the first moment to the second moment.
+*/
+type DurationClassLike interface {
+	// Constructor Methods
+	Duration(
+		duration tim.Duration,
+	) DurationLike
+	DurationFromString(
+		source string,
+	) DurationLike
+
+	// Function Methods
+	Between(
+		first MomentLike,
+		second MomentLike,
+	) DurationLike
+}
+
 /*
 IntervalClassLike[V Discrete] is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -79,6 +127,23 @@ supported by each concrete interval-like class.
 An interval-like class defines two endpoints for a finite discrete sequence of
 elements.  The endpoints may be inclusive (denoted by a square bracket) or
 exclusive (denoted by a round bracket).
+
+The elements in an interval are constructed from their integer form using a
+factory function.  An interval may only be created without a factory function
+if its elements support the Successive aspect, in which case each element is
+found by stepping through the successors of the minimum, which takes time
+proportional to its offset from the minimum.  Any attempt to create an interval
+without a factory function for elements that are not successive will result in
+a panic.
+
+Since each element of an interval must have a positive index, the effective size
+of an interval cannot exceed the maximum Go int (e.g. [MinInt..MaxInt] is not a
+valid interval).
+
+The elements of an interval are never materialized unless they are requested as
+a Go array.  Since an interval may span a very large domain, an optional limit
+may be placed on the number of elements that can be materialized at once.  The
+default limit of zero means that there is no limit.
 */
 type IntervalClassLike[V Discrete] interface {
 	// Constructor Methods
@@ -88,6 +153,80 @@ type IntervalClassLike[V Discrete] interface {
 		maximum V,
 		right Bracket,
 	) IntervalLike[V]
+	IntervalWithFactory(
+		left Bracket,
+		minimum V,
+		maximum V,
+		right Bracket,
+		factory FactoryFunction[V],
+	) IntervalLike[V]
+}
+
+/*
+IntervalTreeClassLike[R Ranged[E], E any, V any] is a class interface that
+declares the complete set of class constructors, constants and functions that
+must be supported by each concrete interval-tree-like class.
+
+An interval-tree-like class maintains an ordered collection of bounded ranges,
+each of which is associated with a generic typed value.  The ranges are ordered
+by their minimum endpoints and then by their maximum endpoints.  An interval
+tree can determine which of its ranges contain a specific element—or overlap a
+specific range—in O[log(n) + k] time where k is the number of matching ranges.
+
+The endpoints of the ranges are ranked using a ranking function.  If no ranking
+function is specified the endpoints are ranked using their "natural" ordering.
+An endpoint that is not defined (see the Continuous aspect) is treated as being
+unbounded.  A range must not be modified while it is in an interval tree.
+*/
+type IntervalTreeClassLike[R Ranged[E], E any, V any] interface {
+	// Constructor Methods
+	IntervalTree() IntervalTreeLike[R, E, V]
+	IntervalTreeWithRanker(
+		ranker age.RankingFunction[E],
+	) IntervalTreeLike[R, E, V]
+}
+
+/*
+MomentClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+moment-like class.
+
+A moment-like class captures a point in time with millisecond precision.  The
+source form of a moment is an ISO-8601 timestamp in UTC (e.g.
+"2025-01-02T03:04:05.000Z") and its float form is the number of milliseconds
+since the Unix epoch.  This allows moments to be used as the endpoints of a
+continuum or a spectrum.
+
+The integer form of a moment is also the number of milliseconds since the Unix
+epoch.  The AsDay() and AsHour() methods return the UTC calendar day or the hour
+that contains a moment.
+
+The following class functions are also supported:
+
+Days() returns an interval containing each UTC calendar day from the day of the
+first moment through the day of the last moment.
+
+Hours() returns an interval containing each hour from the hour of the first
+moment through the hour of the last moment.
+*/
+type MomentClassLike interface {
+	// Constructor Methods
+	Moment(
+		time tim.Time,
+	) MomentLike
+	MomentFromString(
+		source string,
+	) MomentLike
+
+	// Function Methods
+	Days(
+		first MomentLike,
+		last MomentLike,
+	) IntervalLike[DayLike]
+	Hours(
+		first MomentLike,
+		last MomentLike,
+	) IntervalLike[HourLike]
 }
 
 /*
@@ -98,6 +237,15 @@ must be supported by each concrete spectrum-like class.
 A spectrum-like class defines two endpoints for an infinite discrete sequence
 of elements.  The endpoints may be inclusive (denoted by a square bracket) or
 exclusive (denoted by a round bracket).
+
+When the elements in a spectrum also support the Successive aspect, the
+AsSequence() method returns a sequence of the elements from its minimum to its
+maximum based on its current endpoints.  The elements are only generated as the
+sequence is traversed.  Since a spectrum may span a very large domain, the
+method returns with ok set to false if the spectrum contains more elements than
+the specified limit, unless the limit is zero, in which case the sequence is
+unbounded.  Any attempt to traverse a spectrum whose elements do not support the
+Successive aspect will result in a panic.
 */
 type SpectrumClassLike[V Ordered[V]] interface {
 	// Constructor Methods
@@ -123,6 +271,60 @@ type ContinuumLike[V Continuous] interface {
 	// Aspect Interfaces
 	Bounded[V]
 	col.Searchable[V]
+	age.Serializable
+}
+
+/*
+DayLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete day-like class.
+
+A day-like class captures a UTC calendar day.  Its integer form is the number
+of whole days since the Unix epoch, which allows days to be the elements of an
+interval.
+*/
+type DayLike interface {
+	// Principal Methods
+	AsMoment() MomentLike
+	AsTime() tim.Time
+
+	// Aspect Interfaces
+	Discrete
+	Ordered[DayLike]
+}
+
+/*
+DurationLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete duration-like class.
+*/
+type DurationLike interface {
+	// Principal Methods
+	GetClass() DurationClassLike
+	AsDuration() tim.Duration
+
+	// Aspect Interfaces
+	Continuous
+	Ordered[DurationLike]
+}
+
+/*
+HourLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete hour-like class.
+
+An hour-like class captures an hour in UTC.  Its integer form is the number of
+whole hours since the Unix epoch, which allows hours to be the elements of an
+interval.
+*/
+type HourLike interface {
+	// Principal Methods
+	AsMoment() MomentLike
+	AsTime() tim.Time
+
+	// Aspect Interfaces
+	Discrete
+	Ordered[HourLike]
 }
 
 /*
@@ -134,13 +336,72 @@ type IntervalLike[V Discrete] interface {
 	// Principal Methods
 	GetClass() IntervalClassLike[V]
 
+	// Attribute Methods
+	GetFactory() FactoryFunction[V]
+	GetLimit() uint
+	SetLimit(
+		limit uint,
+	)
+
 	// Aspect Interfaces
 	col.Accessible[V]
 	Bounded[V]
 	col.Searchable[V]
+	age.Serializable
 	col.Sequential[V]
 }
 
+/*
+IntervalTreeLike[R Ranged[E], E any, V any] is an instance interface that
+declares the complete set of principal, attribute and aspect methods that must
+be supported by each instance of a concrete interval-tree-like class.
+*/
+type IntervalTreeLike[R Ranged[E], E any, V any] interface {
+	// Principal Methods
+	GetClass() IntervalTreeClassLike[R, E, V]
+	GetValue(
+		range_ R,
+	) V
+	SetValue(
+		range_ R,
+		value V,
+	)
+	RemoveValue(
+		range_ R,
+	) V
+	RemoveAll()
+	GetContaining(
+		element E,
+	) col.Sequential[col.AssociationLike[R, V]]
+	GetOverlapping(
+		range_ R,
+	) col.Sequential[col.AssociationLike[R, V]]
+
+	// Attribute Methods
+	GetRanker() age.RankingFunction[E]
+
+	// Aspect Interfaces
+	col.Sequential[col.AssociationLike[R, V]]
+}
+
+/*
+MomentLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete moment-like class.
+*/
+type MomentLike interface {
+	// Principal Methods
+	GetClass() MomentClassLike
+	AsTime() tim.Time
+	AsDay() DayLike
+	AsHour() HourLike
+
+	// Aspect Interfaces
+	Continuous
+	Discrete
+	Ordered[MomentLike]
+}
+
 /*
 SpectrumLike[V Ordered[V]] is an instance interface that declares the complete
 set of principal, attribute and aspect methods that must be supported by each
@@ -149,10 +410,17 @@ instance of a concrete spectrum-like class.
 type SpectrumLike[V Ordered[V]] interface {
 	// Principal Methods
 	GetClass() SpectrumClassLike[V]
+	AsSequence(
+		limit uint,
+	) (
+		sequence col.Sequential[V],
+		ok bool,
+	)
 
 	// Aspect Interfaces
 	Bounded[V]
 	col.Searchable[V]
+	age.Serializable
 }
 
 // ASPECT DECLARATIONS
@@ -213,3 +481,23 @@ type Ordered[V any] interface {
 		value V,
 	) bool
 }
+
+/*
+Ranged[V any] is an aspect interface that declares the set of constraints that
+must be satisfied by each bounded class that is used to key an interval tree.
+*/
+type Ranged[V any] interface {
+	comparable
+	Bounded[V]
+}
+
+/*
+Successive[V any] is an aspect interface that declares a set of method
+signatures that must be supported by each instance of a successive class.
+
+A successive class knows which of its instances immediately follows it in its
+ordering.  Each successor must be ordered after the instance that produced it.
+*/
+type Successive[V any] interface {
+	GetNext() V
+}